@@ -0,0 +1,255 @@
+package fynesimplechart
+
+import (
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+)
+
+// AnchorSpace defines how the coordinates of an Anchor are read
+type AnchorSpace int
+
+const (
+	AnchorData     AnchorSpace = iota // Default: data coordinates, follows the axis ranges
+	AnchorFraction                    // Fractions of the plot area, (0, 0) bottom left and (1, 1) top right
+	AnchorPixel                       // Pixels from the top left corner of the plot area
+)
+
+// Anchor is a point an annotation is attached to
+type Anchor struct {
+	X     float32
+	Y     float32
+	Space AnchorSpace
+}
+
+// DataAnchor returns an anchor at data coordinates (x, y)
+func DataAnchor(x, y float32) Anchor {
+	return Anchor{X: x, Y: y, Space: AnchorData}
+}
+
+// FractionAnchor returns an anchor at a fraction of the plot area
+func FractionAnchor(x, y float32) Anchor {
+	return Anchor{X: x, Y: y, Space: AnchorFraction}
+}
+
+// PixelAnchor returns an anchor at a pixel offset into the plot area
+func PixelAnchor(x, y float32) Anchor {
+	return Anchor{X: x, Y: y, Space: AnchorPixel}
+}
+
+// AnnotationKind defines what an annotation draws
+type AnnotationKind int
+
+const (
+	AnnotationText    AnnotationKind = iota // Default: Text at At
+	AnnotationArrow                         // Arrow from From to At, with Text at From
+	AnnotationMarker                        // Marker at At, with Text beside it
+	AnnotationCallout                       // Boxed Text at From, with a leader line to At
+)
+
+// Annotation marks a point of the chart with text, an arrow, a marker or a
+// callout box. Annotations whose At anchor falls outside the plot area are
+// not drawn.
+type Annotation struct {
+	Kind AnnotationKind
+	At   Anchor // Text position, arrow tip, marker or callout target
+	From Anchor // Arrow tail or callout box centre
+	Text string
+
+	Color     color.Color // Text, line and marker color (nil uses theme foreground)
+	TextSize  float32
+	Alignment fyne.TextAlign // Horizontal alignment of text annotations on At
+	LineWidth float32
+	HeadSize  float32 // Arrowhead length in pixels
+
+	// Marker properties
+	Marker     MarkerShape
+	MarkerSize float32
+
+	// Callout properties
+	BoxColor    color.Color // Box fill (nil uses theme background)
+	BorderColor color.Color // Box border (nil uses Color)
+	Padding     float32     // Space between the text and the box border
+}
+
+func newAnnotation(kind AnnotationKind, at, from Anchor, text string) *Annotation {
+	annotation := &Annotation{
+		Kind:        kind,
+		At:          at,
+		From:        from,
+		Text:        text,
+		Color:       nil, // Will use theme foreground if nil
+		TextSize:    10,
+		Alignment:   fyne.TextAlignLeading,
+		LineWidth:   1,
+		HeadSize:    6,
+		Marker:      MarkerCircle,
+		MarkerSize:  4,
+		BoxColor:    nil,
+		BorderColor: nil,
+		Padding:     4,
+	}
+
+	return annotation
+}
+
+// NewTextAnnotation creates a text label at an anchor
+func NewTextAnnotation(at Anchor, text string) *Annotation {
+	return newAnnotation(AnnotationText, at, at, text)
+}
+
+// NewArrowAnnotation creates an arrow pointing from one anchor to another,
+// labelled with text at its tail
+func NewArrowAnnotation(from, to Anchor, text string) *Annotation {
+	return newAnnotation(AnnotationArrow, to, from, text)
+}
+
+// NewMarkerAnnotation creates a marker at an anchor with text beside it
+func NewMarkerAnnotation(at Anchor, shape MarkerShape, text string) *Annotation {
+	annotation := newAnnotation(AnnotationMarker, at, at, text)
+	annotation.Marker = shape
+	return annotation
+}
+
+// NewCalloutAnnotation creates a boxed label with a leader line to target
+func NewCalloutAnnotation(target, box Anchor, text string) *Annotation {
+	return newAnnotation(AnnotationCallout, target, box, text)
+}
+
+// anchorTransform returns a function from anchors to screen coordinates for
+// the data transform t, and one reporting whether a screen point is in the plot area
+func anchorTransform(t dataTransform) (toScreen func(Anchor) fyne.Position, inside func(fyne.Position) bool) {
+	toScreen = func(a Anchor) fyne.Position {
+		switch a.Space {
+		case AnchorFraction:
+			return fyne.NewPos(t.left+a.X*t.width, t.top+t.height-a.Y*t.height)
+		case AnchorPixel:
+			return fyne.NewPos(t.left+a.X, t.top+a.Y)
+		default:
+			return t.position(a.X, a.Y)
+		}
+	}
+	inside = func(p fyne.Position) bool {
+		return isFinite(p.X) && isFinite(p.Y) &&
+			p.X >= t.left-0.5 && p.X <= t.left+t.width+0.5 && p.Y >= t.top-0.5 && p.Y <= t.top+t.height+0.5
+	}
+	return toScreen, inside
+}
+
+// Draw every annotation over the series
+func (r *scatterChartRenderer) drawAnnotations(minX, maxX, minY, maxY, plotWidth, plotHeight, mLeft, mTop float32) {
+	toScreen, inside := anchorTransform(newDataTransform(minX, maxX, minY, maxY, plotWidth, plotHeight, mLeft, mTop))
+
+	for _, a := range r.widget.Annotations {
+		at := toScreen(a.At)
+		if !inside(at) {
+			continue
+		}
+
+		annotationColor := a.Color
+		if annotationColor == nil {
+			annotationColor = r.scene.Foreground
+		}
+
+		switch a.Kind {
+		case AnnotationArrow:
+			r.drawAnnotationArrow(a, toScreen(a.From), at, annotationColor)
+		case AnnotationMarker:
+			r.drawMarker(a.Marker, at.X, at.Y, a.MarkerSize, annotationColor, annotationColor, 0)
+			if a.Text != "" {
+				label := r.annotationText(a, annotationColor)
+				label.Move(fyne.NewPos(at.X+a.MarkerSize+3, at.Y-label.MinSize().Height/2))
+			}
+		case AnnotationCallout:
+			r.drawAnnotationCallout(a, toScreen(a.From), at, annotationColor)
+		default:
+			if a.Text != "" {
+				label := r.annotationText(a, annotationColor)
+				size := label.MinSize()
+				x := at.X
+				switch a.Alignment {
+				case fyne.TextAlignCenter:
+					x -= size.Width / 2
+				case fyne.TextAlignTrailing:
+					x -= size.Width
+				}
+				label.Move(fyne.NewPos(x, at.Y-size.Height/2))
+			}
+		}
+	}
+}
+
+// annotationText adds the text of an annotation and returns it for placement
+func (r *scatterChartRenderer) annotationText(a Annotation, textColor color.Color) *SceneText {
+	label := r.scene.newText(a.Text, textColor)
+	label.TextSize = a.TextSize
+	r.scene.add(label)
+	return label
+}
+
+// Draw an arrow annotation with its text centred beyond the tail
+func (r *scatterChartRenderer) drawAnnotationArrow(a Annotation, tail, tip fyne.Position, arrowColor color.Color) {
+	dx, dy := tip.X-tail.X, tip.Y-tail.Y
+	length := float32(math.Hypot(float64(dx), float64(dy)))
+
+	if length > 0 {
+		shaft := r.scene.newLine(arrowColor)
+		shaft.StrokeWidth = a.LineWidth
+		shaft.Position1 = tail
+		shaft.Position2 = tip
+		r.scene.add(shaft)
+
+		headSize := float32(math.Min(float64(a.HeadSize), float64(length/2)))
+		r.drawArrowhead(tip, dx/length, dy/length, headSize, a.LineWidth, arrowColor)
+	}
+
+	if a.Text == "" {
+		return
+	}
+
+	// Text sits on the side of the tail away from the tip
+	label := r.annotationText(a, arrowColor)
+	size := label.MinSize()
+	y := tail.Y - size.Height - 2
+	if dy < 0 {
+		y = tail.Y + 2
+	}
+	label.Move(fyne.NewPos(tail.X-size.Width/2, y))
+}
+
+// Draw a callout box centred on box with a leader line to target
+func (r *scatterChartRenderer) drawAnnotationCallout(a Annotation, box, target fyne.Position, calloutColor color.Color) {
+	label := r.scene.newText(a.Text, calloutColor)
+	label.TextSize = a.TextSize
+	size := label.MinSize()
+	boxWidth := size.Width + 2*a.Padding
+	boxHeight := size.Height + 2*a.Padding
+	topLeft := fyne.NewPos(box.X-boxWidth/2, box.Y-boxHeight/2)
+
+	// Leader line first, so the box covers its end
+	leader := r.scene.newLine(calloutColor)
+	leader.StrokeWidth = a.LineWidth
+	leader.Position1 = box
+	leader.Position2 = target
+	r.scene.add(leader)
+
+	boxColor := a.BoxColor
+	if boxColor == nil {
+		boxColor = r.scene.Background
+	}
+	borderColor := a.BorderColor
+	if borderColor == nil {
+		borderColor = calloutColor
+	}
+
+	rect := r.scene.newRectangle(boxColor)
+	rect.StrokeColor = borderColor
+	rect.StrokeWidth = a.LineWidth
+	rect.Move(topLeft)
+	rect.Resize(fyne.NewSize(boxWidth, boxHeight))
+	r.scene.add(rect)
+
+	label.Move(fyne.NewPos(topLeft.X+a.Padding, topLeft.Y+a.Padding))
+	r.scene.add(label)
+}
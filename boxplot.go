@@ -0,0 +1,329 @@
+package fynesimplechart
+
+import (
+	"errors"
+	"image/color"
+	"math"
+	"sort"
+
+	"fyne.io/fyne/v2"
+)
+
+// WhiskerRule defines how far the whiskers of a box plot reach
+type WhiskerRule int
+
+const (
+	WhiskerIQR    WhiskerRule = iota // Default: furthest sample within 1.5·IQR of the box, the rest are outliers
+	WhiskerMinMax                    // Whiskers span the full sample range
+)
+
+// BoxCategory is a named group of raw samples summarized by one box
+type BoxCategory struct {
+	Label   string
+	Samples []float32
+}
+
+// BoxStats holds the summary statistics drawn for a single box
+type BoxStats struct {
+	Count        int
+	Min          float32
+	Q1           float32
+	Median       float32
+	Q3           float32
+	Max          float32
+	Mean         float32
+	LowerWhisker float32
+	UpperWhisker float32
+	NotchLow     float32 // Lower bound of the median confidence interval
+	NotchHigh    float32 // Upper bound of the median confidence interval
+	Outliers     []float32
+}
+
+type BoxPlot struct {
+	Categories []BoxCategory
+	Title      string
+
+	BoxColor  color.Color // Outline color (nil uses auto-generated color)
+	FillColor color.Color // Box fill (nil uses BoxColor with transparency)
+	BoxWidth  float32     // Width of boxes as a fraction of category spacing (default: 0.5)
+	LineWidth float32
+	Offset    float32 // X offset of every box, for grouping several box plots per category
+
+	WhiskerRule  WhiskerRule
+	ShowOutliers bool
+	OutlierSize  float32
+	ShowNotches  bool // Notch the box around the median confidence interval
+	ShowMean     bool // Mark the mean with a cross
+}
+
+func NewBoxPlot(categories []BoxCategory, title string) *BoxPlot {
+	box := &BoxPlot{
+		Categories:   categories,
+		Title:        title,
+		BoxColor:     nil, // Will use auto-generated color if nil
+		FillColor:    nil,
+		BoxWidth:     0.5,
+		LineWidth:    1.5,
+		Offset:       0,
+		WhiskerRule:  WhiskerIQR,
+		ShowOutliers: true,
+		OutlierSize:  3.0,
+		ShowNotches:  false,
+		ShowMean:     false,
+	}
+
+	return box
+}
+
+// ComputeBoxStats summarizes samples into quartiles, whiskers and outliers.
+// Quartiles are linearly interpolated between closest ranks.
+func ComputeBoxStats(samples []float32, rule WhiskerRule) (BoxStats, error) {
+	// Missing samples are left out
+	sorted := make([]float32, 0, len(samples))
+	for _, s := range samples {
+		if isFinite(s) {
+			sorted = append(sorted, s)
+		}
+	}
+
+	if len(sorted) == 0 {
+		return BoxStats{}, errors.New("No samples to summarize.")
+	}
+
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	n := len(sorted)
+	stats := BoxStats{
+		Count:  n,
+		Min:    sorted[0],
+		Q1:     quantile(sorted, 0.25),
+		Median: quantile(sorted, 0.5),
+		Q3:     quantile(sorted, 0.75),
+		Max:    sorted[n-1],
+	}
+
+	sum := float64(0)
+	for _, s := range sorted {
+		sum += float64(s)
+	}
+	stats.Mean = float32(sum / float64(n))
+
+	iqr := stats.Q3 - stats.Q1
+	notch := float32(1.57 * float64(iqr) / math.Sqrt(float64(n)))
+	stats.NotchLow = stats.Median - notch
+	stats.NotchHigh = stats.Median + notch
+
+	if rule == WhiskerMinMax {
+		stats.LowerWhisker = stats.Min
+		stats.UpperWhisker = stats.Max
+		return stats, nil
+	}
+
+	lowerFence := stats.Q1 - 1.5*iqr
+	upperFence := stats.Q3 + 1.5*iqr
+	stats.LowerWhisker = stats.Q1
+	stats.UpperWhisker = stats.Q3
+	for _, s := range sorted {
+		if s < lowerFence || s > upperFence {
+			stats.Outliers = append(stats.Outliers, s)
+			continue
+		}
+		if s < stats.LowerWhisker {
+			stats.LowerWhisker = s
+		}
+		if s > stats.UpperWhisker {
+			stats.UpperWhisker = s
+		}
+	}
+
+	return stats, nil
+}
+
+// quantile returns the q-th quantile of sorted samples
+func quantile(sorted []float32, q float64) float32 {
+	pos := q * float64(len(sorted)-1)
+	lower := int(math.Floor(pos))
+	upper := int(math.Ceil(pos))
+	t := float32(pos - float64(lower))
+	return sorted[lower] + t*(sorted[upper]-sorted[lower])
+}
+
+// categoryX returns the X position of the box for category i
+func (b BoxPlot) categoryX(i int) float32 {
+	return float32(i+1) + b.Offset
+}
+
+// boxWidth returns BoxWidth, or the default of 0.5 if it is unset
+func (b BoxPlot) boxWidth() float32 {
+	if b.BoxWidth <= 0 {
+		return 0.5
+	}
+	return b.BoxWidth
+}
+
+// extendRange grows bounds to contain every box, whisker and outlier
+func (b BoxPlot) extendRange(bounds *dataRange) {
+	halfWidth := b.boxWidth() / 2
+
+	for i, category := range b.Categories {
+		stats, err := ComputeBoxStats(category.Samples, b.WhiskerRule)
+		if err != nil {
+			continue
+		}
+
+		x := b.categoryX(i)
+		bounds.include(x-halfWidth, stats.LowerWhisker)
+		bounds.include(x+halfWidth, stats.UpperWhisker)
+
+		if b.ShowOutliers {
+			for _, o := range stats.Outliers {
+				bounds.include(x, o)
+			}
+		}
+	}
+}
+
+// Draw a box plot
+func (r *scatterChartRenderer) drawBoxPlot(box BoxPlot, boxColor color.Color, minX, maxX, minY, maxY, plotWidth, plotHeight, mLeft, mTop float32) {
+	// Transform functions from data coordinates to screen coordinates
+	transform := newDataTransform(minX, maxX, minY, maxY, plotWidth, plotHeight, mLeft, mTop)
+	dataToScreenX, dataToScreenY := transform.x, transform.y
+
+	fillColor := box.FillColor
+	if fillColor == nil {
+		fillColor = translucent(boxColor)
+	}
+
+	boxWidthScreen := box.boxWidth() * (plotWidth / (maxX - minX))
+
+	addLine := func(x1, y1, x2, y2, width float32) {
+		line := r.scene.newLine(boxColor)
+		line.StrokeWidth = width
+		line.Position1 = fyne.NewPos(x1, y1)
+		line.Position2 = fyne.NewPos(x2, y2)
+		r.scene.add(line)
+	}
+
+	for i, category := range box.Categories {
+		stats, err := ComputeBoxStats(category.Samples, box.WhiskerRule)
+		if err != nil {
+			continue
+		}
+
+		centerX := dataToScreenX(box.categoryX(i))
+		left := centerX - boxWidthScreen/2
+		right := centerX + boxWidthScreen/2
+		q1Y := dataToScreenY(stats.Q1)
+		q3Y := dataToScreenY(stats.Q3)
+		medianY := dataToScreenY(stats.Median)
+
+		// Whiskers with caps
+		capHalf := boxWidthScreen / 4
+		lowY := dataToScreenY(stats.LowerWhisker)
+		highY := dataToScreenY(stats.UpperWhisker)
+		addLine(centerX, q1Y, centerX, lowY, box.LineWidth)
+		addLine(centerX-capHalf, lowY, centerX+capHalf, lowY, box.LineWidth)
+		addLine(centerX, q3Y, centerX, highY, box.LineWidth)
+		addLine(centerX-capHalf, highY, centerX+capHalf, highY, box.LineWidth)
+
+		// Box from Q1 to Q3
+		medianLeft, medianRight := left, right
+		if box.ShowNotches {
+			// Keep the notch inside the box
+			notchLow := float32(math.Max(float64(stats.NotchLow), float64(stats.Q1)))
+			notchHigh := float32(math.Min(float64(stats.NotchHigh), float64(stats.Q3)))
+			notchLowY := dataToScreenY(notchLow)
+			notchHighY := dataToScreenY(notchHigh)
+			inset := boxWidthScreen / 4
+			medianLeft, medianRight = left+inset, right-inset
+
+			outline := []fyne.Position{
+				fyne.NewPos(left, q3Y),
+				fyne.NewPos(right, q3Y),
+				fyne.NewPos(right, notchHighY),
+				fyne.NewPos(medianRight, medianY),
+				fyne.NewPos(right, notchLowY),
+				fyne.NewPos(right, q1Y),
+				fyne.NewPos(left, q1Y),
+				fyne.NewPos(left, notchLowY),
+				fyne.NewPos(medianLeft, medianY),
+				fyne.NewPos(left, notchHighY),
+			}
+			r.scene.add(newPolygon([][]fyne.Position{outline}, fillColor))
+
+			for k := range outline {
+				next := outline[(k+1)%len(outline)]
+				addLine(outline[k].X, outline[k].Y, next.X, next.Y, box.LineWidth)
+			}
+		} else {
+			rect := r.scene.newRectangle(fillColor)
+			rect.StrokeColor = boxColor
+			rect.StrokeWidth = box.LineWidth
+			rect.Move(fyne.NewPos(left, q3Y))
+			rect.Resize(fyne.NewSize(boxWidthScreen, q1Y-q3Y))
+			r.scene.add(rect)
+		}
+
+		// Median line
+		addLine(medianLeft, medianY, medianRight, medianY, box.LineWidth+1)
+
+		// Mean marker
+		if box.ShowMean {
+			meanY := dataToScreenY(stats.Mean)
+			size := float32(4)
+			addLine(centerX-size, meanY-size, centerX+size, meanY+size, box.LineWidth)
+			addLine(centerX-size, meanY+size, centerX+size, meanY-size, box.LineWidth)
+		}
+
+		// Outliers as hollow markers
+		if box.ShowOutliers {
+			for _, o := range stats.Outliers {
+				y := dataToScreenY(o)
+				radius := box.OutlierSize
+
+				circle := r.scene.newCircle(color.Transparent)
+				circle.StrokeColor = boxColor
+				circle.StrokeWidth = 1
+				circle.Resize(fyne.NewSize(radius*2, radius*2))
+				circle.Move(fyne.NewPos(centerX-radius, y-radius))
+				r.scene.add(circle)
+			}
+		}
+
+		r.drawCategoryLabel(category.Label, centerX, mTop+plotHeight)
+	}
+}
+
+// Draw a category label centered on x below the plot area, under the tick labels
+func (r *scatterChartRenderer) drawCategoryLabel(text string, x, plotBottom float32) {
+	if text == "" {
+		return
+	}
+
+	label := r.scene.newText(text, r.scene.Foreground)
+	label.TextSize = 10
+	labelWidth := label.MinSize().Width
+	label.Move(fyne.NewPos(x-labelWidth/2, plotBottom+20))
+	r.scene.add(label)
+}
+
+// Draw a legend item for a box plot
+func (r *scatterChartRenderer) drawBoxLegendItem(box BoxPlot, boxColor color.Color, x, y float32) {
+	fillColor := box.FillColor
+	if fillColor == nil {
+		fillColor = translucent(boxColor)
+	}
+
+	rect := r.scene.newRectangle(fillColor)
+	rect.StrokeColor = boxColor
+	rect.StrokeWidth = 1
+	rect.Resize(fyne.NewSize(12, 12))
+	rect.Move(fyne.NewPos(x+10, y+2))
+	r.scene.add(rect)
+
+	// Label
+	label := r.scene.newText(box.Title, r.scene.Foreground)
+	label.TextSize = 10
+	label.Move(fyne.NewPos(x+30, y))
+	r.scene.add(label)
+}
@@ -0,0 +1,76 @@
+package fynesimplechart_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/alexiusacademia/fynesimplechart"
+)
+
+func TestComputeBoxStats(t *testing.T) {
+	nan := float32(math.NaN())
+	tests := []struct {
+		name    string
+		samples []float32
+		rule    fynesimplechart.WhiskerRule
+		want    fynesimplechart.BoxStats
+	}{
+		{
+			// Quartiles at ranks 2.25, 4.5 and 6.75; fences at -3.5 and 14.5;
+			// notch ±1.57·4.5/√10
+			name:    "outlier beyond the fence",
+			samples: []float32{9, 1, 8, 2, 100, 7, 3, 6, 4, 5},
+			rule:    fynesimplechart.WhiskerIQR,
+			want: fynesimplechart.BoxStats{
+				Count: 10, Min: 1, Q1: 3.25, Median: 5.5, Q3: 7.75, Max: 100, Mean: 14.5,
+				LowerWhisker: 1, UpperWhisker: 9, NotchLow: 3.265860, NotchHigh: 7.734140,
+				Outliers: []float32{100},
+			},
+		},
+		{
+			name:    "whiskers over the full range",
+			samples: []float32{9, 1, 8, 2, 100, 7, 3, 6, 4, 5},
+			rule:    fynesimplechart.WhiskerMinMax,
+			want: fynesimplechart.BoxStats{
+				Count: 10, Min: 1, Q1: 3.25, Median: 5.5, Q3: 7.75, Max: 100, Mean: 14.5,
+				LowerWhisker: 1, UpperWhisker: 100, NotchLow: 3.265860, NotchHigh: 7.734140,
+			},
+		},
+		{
+			// Missing samples are left out, leaving 1 to 5
+			name:    "missing samples",
+			samples: []float32{5, nan, 1, 4, 2, 3, float32(math.Inf(1))},
+			rule:    fynesimplechart.WhiskerIQR,
+			want: fynesimplechart.BoxStats{
+				Count: 5, Min: 1, Q1: 2, Median: 3, Q3: 4, Max: 5, Mean: 3,
+				LowerWhisker: 1, UpperWhisker: 5, NotchLow: 1.595749, NotchHigh: 4.404251,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := fynesimplechart.ComputeBoxStats(tt.samples, tt.rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Compare the notch to float32 precision, everything else exactly
+			if math.Abs(float64(got.NotchLow-tt.want.NotchLow)) > 1e-5 || math.Abs(float64(got.NotchHigh-tt.want.NotchHigh)) > 1e-5 {
+				t.Errorf("notch = %v..%v, want %v..%v", got.NotchLow, got.NotchHigh, tt.want.NotchLow, tt.want.NotchHigh)
+			}
+			got.NotchLow, got.NotchHigh = tt.want.NotchLow, tt.want.NotchHigh
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("stats = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestComputeBoxStatsNoSamples(t *testing.T) {
+	samples := []float32{float32(math.NaN())}
+	if _, err := fynesimplechart.ComputeBoxStats(samples, fynesimplechart.WhiskerIQR); err == nil {
+		t.Error("no error for samples that are all missing")
+	}
+}
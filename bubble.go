@@ -0,0 +1,169 @@
+package fynesimplechart
+
+import (
+	"image/color"
+	"math"
+	"sort"
+
+	"fyne.io/fyne/v2"
+)
+
+// SizeScale defines how Node.Size maps to the radius of a point
+type SizeScale int
+
+const (
+	SizeFixed  SizeScale = iota // Default: every point uses PointSize
+	SizeLinear                  // Radius grows linearly from MinPointSize to MaxPointSize
+	SizeArea                    // Area is proportional to Node.Size, up to MaxPointSize
+)
+
+// sizeDomain returns the smallest and largest Node.Size in the plot
+func (p Plot) sizeDomain() (lo, hi float32) {
+	first := true
+	for _, n := range p.Nodes {
+		if !isFinite(n.Size) {
+			continue
+		}
+		if first {
+			lo, hi, first = n.Size, n.Size, false
+			continue
+		}
+		lo = float32(math.Min(float64(lo), float64(n.Size)))
+		hi = float32(math.Max(float64(hi), float64(n.Size)))
+	}
+	return lo, hi
+}
+
+// colorDomain returns the low and high ends of the plot's colour scale
+func (p Plot) colorDomain() (lo, hi float32, ok bool) {
+	values := make([]float32, len(p.Nodes))
+	for i, n := range p.Nodes {
+		values[i] = n.Value
+	}
+	return p.ColorScale.domain(values)
+}
+
+// pointDomains holds the size and colour domains of a plot's points
+type pointDomains struct {
+	sizeLo, sizeHi   float32
+	colorLo, colorHi float32
+	colorOK          bool
+}
+
+// pointDomains returns the size and colour domains of every node in the
+// plot, so points and legend share them however the plot is drawn
+func (p Plot) pointDomains() pointDomains {
+	var d pointDomains
+	if p.SizeScale != SizeFixed {
+		d.sizeLo, d.sizeHi = p.sizeDomain()
+	}
+	if p.ColorByValue {
+		d.colorLo, d.colorHi, d.colorOK = p.colorDomain()
+	}
+	return d
+}
+
+// sizeRadius returns the radius of a point of the given size on a scale
+// running from lo to hi
+func (p Plot) sizeRadius(size, lo, hi float32) float32 {
+	switch p.SizeScale {
+	case SizeLinear:
+		if hi == lo {
+			return p.MaxPointSize
+		}
+		t := (size - lo) / (hi - lo)
+		return p.MinPointSize + t*(p.MaxPointSize-p.MinPointSize)
+	case SizeArea:
+		if hi <= 0 || size <= 0 {
+			return 0
+		}
+		return p.MaxPointSize * float32(math.Sqrt(float64(size/hi)))
+	}
+	return p.PointSize
+}
+
+// pointRadius returns the radius used to draw node
+func (p Plot) pointRadius(node Node, lo, hi float32) float32 {
+	if !isFinite(node.Size) {
+		return p.PointSize
+	}
+	return p.sizeRadius(node.Size, lo, hi)
+}
+
+// pointOrder returns node indices in drawing order. Sized points are drawn
+// largest first so small bubbles stay visible on top.
+func (p Plot) pointOrder() []int {
+	order := make([]int, len(p.Nodes))
+	for i := range order {
+		order[i] = i
+	}
+
+	if p.SizeScale != SizeFixed {
+		sort.SliceStable(order, func(a, b int) bool {
+			return p.Nodes[order[a]].Size > p.Nodes[order[b]].Size
+		})
+	}
+
+	return order
+}
+
+// sizeLegendBlock returns a legend block with nested reference circles for
+// the smallest, middle and largest sizes of a plot
+func (r *scatterChartRenderer) sizeLegendBlock(plot Plot, lo, hi float32, horizontal bool) legendBlock {
+	maxRadius := float32(math.Max(float64(plot.sizeRadius(hi, lo, hi)), 1))
+	width := float32(90)
+	if horizontal {
+		width = 2*maxRadius + 60
+	}
+
+	return legendBlock{
+		width:  width,
+		height: 2*maxRadius + 24,
+		draw: func(x, y float32) {
+			r.drawSizeLegend(plot, lo, hi, maxRadius, x, y)
+		},
+	}
+}
+
+// Draw nested reference circles sitting on a common baseline
+func (r *scatterChartRenderer) drawSizeLegend(plot Plot, lo, hi, maxRadius, x, y float32) {
+	foregroundColor := r.scene.Foreground
+
+	title := r.scene.newText(plot.Title, foregroundColor)
+	title.TextSize = 10
+	title.Move(fyne.NewPos(x+10, y))
+	r.scene.add(title)
+
+	centerX := x + 10 + maxRadius
+	baseline := y + 18 + 2*maxRadius
+	lastLabelY := float32(math.Inf(-1))
+
+	for _, size := range []float32{hi, (lo + hi) / 2, lo} {
+		radius := plot.sizeRadius(size, lo, hi)
+		top := baseline - 2*radius
+		// Skip circles whose labels would overlap the previous one
+		if radius <= 0 || top-lastLabelY < 10 {
+			continue
+		}
+		lastLabelY = top
+
+		circle := r.scene.newCircle(color.Transparent)
+		circle.StrokeColor = foregroundColor
+		circle.StrokeWidth = 1
+		circle.Resize(fyne.NewSize(radius*2, radius*2))
+		circle.Move(fyne.NewPos(centerX-radius, baseline-2*radius))
+		r.scene.add(circle)
+
+		// Leader from the top of the circle to its label
+		leader := r.scene.newLine(foregroundColor)
+		leader.StrokeWidth = 0.5
+		leader.Position1 = fyne.NewPos(centerX, top)
+		leader.Position2 = fyne.NewPos(centerX+maxRadius+6, top)
+		r.scene.add(leader)
+
+		label := r.scene.newText(formatAxisLabel(size), foregroundColor)
+		label.TextSize = 9
+		label.Move(fyne.NewPos(centerX+maxRadius+8, top-label.MinSize().Height/2))
+		r.scene.add(label)
+	}
+}
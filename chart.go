@@ -4,10 +4,9 @@ import (
 	"fmt"
 	"image/color"
 	"math"
+	"sync/atomic"
 
 	"fyne.io/fyne/v2"
-	"fyne.io/fyne/v2/canvas"
-	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 )
 
@@ -37,6 +36,27 @@ type ScatterPlot struct {
 	widget.BaseWidget
 
 	Plots      []Plot
+	BoxPlots   []BoxPlot
+	Heatmaps   []Heatmap
+	Waterfalls []Waterfall
+	Contours   []Contour
+	Quivers    []Quiver
+	Functions  []Function
+
+	ParametricCurves []ParametricCurve
+	ImplicitCurves   []ImplicitCurve
+	DerivedSeries    []DerivedSeries
+
+	// Reference lines and bands, e.g. thresholds and target ranges
+	ReferenceLines []ReferenceLine
+	ReferenceBands []ReferenceBand
+
+	// Text, arrows, markers and callouts drawn over the series
+	Annotations []Annotation
+
+	// Canvas objects pinned to anchors on top of the chart
+	Overlays []Overlay
+
 	ChartTitle string
 	ShowGrid   bool
 
@@ -54,6 +74,16 @@ type ScatterPlot struct {
 	LegendPosition LegendPosition // Where to display the legend
 	ShowLegend     bool            // Whether to show legend
 
+	// Polar properties: Node.X is the angle and Node.Y the radius. MinY/MaxY
+	// and YTickInterval set the rings, XTickInterval the spokes. Only Plots
+	// are drawn, as lines, points and areas filled to the pole or between plots.
+	Polar         bool           // Plot in polar coordinates
+	AngleUnit     AngleUnit      // Unit of Node.X in polar mode
+	ZeroDirection PolarDirection // Where angle zero points
+	Clockwise     bool           // Angles increase clockwise
+
+	view atomic.Pointer[chartView] // Mapping of the last layout on screen
+
 	mTop    float32
 	mBottom float32
 	mLeft   float32
@@ -80,6 +110,11 @@ func NewGraphWidget(plots []Plot) *ScatterPlot {
 		mBottom:        defaultMarginBottom,
 		mLeft:          defaultMarginLeft,
 		mRight:         defaultMarginRight,
+
+		Polar:         false,
+		AngleUnit:     AngleDegrees,
+		ZeroDirection: PolarEast,
+		Clockwise:     false,
 	}
 	w.ExtendBaseWidget(w)
 	return w
@@ -91,162 +126,222 @@ func (v *ScatterPlot) SetChartTitle(title string) {
 	v.Refresh()
 }
 
-// Generates a new renderer for the ScatterPlot.
-func (v *ScatterPlot) CreateRenderer() fyne.WidgetRenderer {
-	v.ExtendBaseWidget(v)
-	return &scatterChartRenderer{widget: v}
+// hasSeries reports whether the chart has anything to draw.
+func (v *ScatterPlot) hasSeries() bool {
+	return len(v.Plots) > 0 || len(v.BoxPlots) > 0 || len(v.Heatmaps) > 0 || len(v.Waterfalls) > 0 || len(v.Contours) > 0 || len(v.Quivers) > 0 || len(v.curveStyles()) > 0 || len(v.DerivedSeries) > 0
 }
 
-// Responsible for rendering the ScatterPlot.
-type scatterChartRenderer struct {
-	widget  *ScatterPlot
-	objects []fyne.CanvasObject
+// dataRange accumulates the data extent of the series on a chart.
+type dataRange struct {
+	minX, maxX, minY, maxY float32
+	ok                     bool
 }
 
-// Calculates the minimum size of the graph.
-func (r *scatterChartRenderer) MinSize() fyne.Size {
-	return r.widget.Size()
-}
-
-// Layout the components.
-func (r *scatterChartRenderer) Layout(size fyne.Size) {
-	r.render()
-}
-
-// Called when the theme changes.
-func (r *scatterChartRenderer) ApplyTheme() {
-	r.render()
-}
-
-// Updates the widget's rendering.
-func (r *scatterChartRenderer) Refresh() {
-	r.render()
-	canvas.Refresh(r.widget)
+// include grows the range to contain the point (x, y).
+func (d *dataRange) include(x, y float32) {
+	if !d.ok {
+		d.minX, d.maxX, d.minY, d.maxY = x, x, y, y
+		d.ok = true
+		return
+	}
+	d.minX = float32(math.Min(float64(d.minX), float64(x)))
+	d.maxX = float32(math.Max(float64(d.maxX), float64(x)))
+	d.minY = float32(math.Min(float64(d.minY), float64(y)))
+	d.maxY = float32(math.Max(float64(d.maxY), float64(y)))
 }
 
-// Returns the background color of the widget.
-func (r *scatterChartRenderer) BackgroundColor() color.Color {
-	return theme.BackgroundColor()
-}
+// dataBounds returns the combined extent of every series on the chart, with
+// the derived series as computed by derivedPlots.
+func (v *ScatterPlot) dataBounds(derived []Plot) dataRange {
+	var bounds dataRange
+
+	if minX, err := MinX(v.Plots); err == nil {
+		maxX, _ := MaxX(v.Plots)
+		minY, _ := MinY(v.Plots)
+		maxY, _ := MaxY(v.Plots)
+		bounds.include(minX, minY)
+		bounds.include(maxX, maxY)
+	}
 
-// Return the objects contained in the widget.
-func (r *scatterChartRenderer) Objects() []fyne.CanvasObject {
-	return r.objects
-}
+	for _, box := range v.BoxPlots {
+		box.extendRange(&bounds)
+	}
 
-// Called when the widget is destroyed.
-func (r *scatterChartRenderer) Destroy() {
-}
+	for _, heatmap := range v.Heatmaps {
+		heatmap.extendRange(&bounds)
+	}
 
-// Main render function
-func (r *scatterChartRenderer) render() {
-	r.objects = []fyne.CanvasObject{}
+	for _, waterfall := range v.Waterfalls {
+		waterfall.extendRange(&bounds)
+	}
 
-	if len(r.widget.Plots) == 0 {
-		return
+	for _, contour := range v.Contours {
+		contour.extendRange(&bounds)
 	}
 
-	mTop, mBottom, mLeft, mRight := r.widget.mTop, r.widget.mBottom, r.widget.mLeft, r.widget.mRight
-	widgetSize := r.widget.Size()
+	for _, quiver := range v.Quivers {
+		quiver.extendRange(&bounds)
+	}
 
-	// Get data bounds (use manual if provided, otherwise auto-calculate)
-	var minX, maxX, minY, maxY float32
+	for _, curve := range v.ParametricCurves {
+		curve.extendRange(&bounds)
+	}
 
-	if r.widget.MinX != nil {
-		minX = *r.widget.MinX
-	} else {
-		val, err := MinX(r.widget.Plots)
-		if err != nil {
-			return
+	for _, plot := range derived {
+		for _, n := range finiteNodes(plot.Nodes) {
+			bounds.include(n.X, n.Y)
 		}
-		minX = val
 	}
 
-	if r.widget.MaxX != nil {
-		maxX = *r.widget.MaxX
-	} else {
-		val, err := MaxX(r.widget.Plots)
-		if err != nil {
-			return
+	// Functions and implicit curves are scanned over the range of everything else
+	if len(v.Functions) > 0 || len(v.ImplicitCurves) > 0 {
+		minX, maxX, minY, maxY := v.scanRange(bounds)
+		for _, function := range v.Functions {
+			function.extendRange(&bounds, minX, maxX)
+		}
+		for _, curve := range v.ImplicitCurves {
+			curve.extendRange(&bounds, minX, maxX, minY, maxY)
 		}
-		maxX = val
 	}
 
-	if r.widget.MinY != nil {
-		minY = *r.widget.MinY
-	} else {
-		val, err := MinY(r.widget.Plots)
-		if err != nil {
-			return
-		}
-		minY = val
+	// References only widen a range that already holds data
+	for _, line := range v.ReferenceLines {
+		line.extendRange(&bounds)
 	}
 
-	if r.widget.MaxY != nil {
-		maxY = *r.widget.MaxY
-	} else {
-		val, err := MaxY(r.widget.Plots)
-		if err != nil {
-			return
-		}
-		maxY = val
+	for _, band := range v.ReferenceBands {
+		band.extendRange(&bounds)
 	}
 
-	// Add 10% padding to the data range (only if using auto-calculated ranges)
-	rangeX := maxX - minX
-	rangeY := maxY - minY
+	return bounds
+}
 
-	if r.widget.MinX == nil && r.widget.MaxX == nil {
-		if rangeX == 0 {
-			rangeX = 1
-			minX -= 0.5
-			maxX += 0.5
-		} else {
-			padding := rangeX * 0.1
-			minX -= padding
-			maxX += padding
-		}
+// Generates a new renderer for the ScatterPlot.
+func (v *ScatterPlot) CreateRenderer() fyne.WidgetRenderer {
+	v.ExtendBaseWidget(v)
+	r := &scatterChartRenderer{widget: v}
+	r.sceneRenderer = sceneRenderer{owner: v, layout: r.layout, overlays: r.layoutOverlays}
+	return r
+}
+
+// Scene lays the chart out at the given size without drawing it, in the
+// colors of Fyne's dark theme. A nil measurer uses ApproximateTextSize, so
+// no running app is needed. Overlays are not part of the scene.
+func (v *ScatterPlot) Scene(size fyne.Size, measure TextMeasurer) *Scene {
+	r := &scatterChartRenderer{widget: v}
+	r.scene = newScene(size, measure)
+	r.layout()
+	return r.scene
+}
+
+// Responsible for rendering the ScatterPlot.
+type scatterChartRenderer struct {
+	sceneRenderer
+	widget *ScatterPlot
+
+	// Mapping of the last layout, where overlays are placed, and whether
+	// it has a visible range
+	view    chartView
+	visible bool
+}
+
+// Calculates the minimum size of the graph.
+func (r *scatterChartRenderer) MinSize() fyne.Size {
+	return r.widget.Size()
+}
+
+// Lay the chart out into the scene
+func (r *scatterChartRenderer) layout() {
+	r.visible = false
+	if r.owner != nil {
+		// Charts on screen keep the mapping for DataToScreen and
+		// ScreenToData; scenes made by Scene leave it alone
+		defer func() {
+			view := r.view
+			r.widget.view.Store(&view)
+		}()
+	}
+	if !r.widget.hasSeries() {
+		r.view = r.widget.newView(r.scene.Size)
+		return
 	}
 
-	if r.widget.MinY == nil && r.widget.MaxY == nil {
-		if rangeY == 0 {
-			rangeY = 1
-			minY -= 0.5
-			maxY += 0.5
-		} else {
-			padding := rangeY * 0.1
-			minY -= padding
-			maxY += padding
-		}
+	// Polar charts share the series and legend but not the Cartesian axes
+	if r.widget.Polar {
+		r.renderPolar()
+		return
 	}
 
-	// Recalculate ranges after padding
-	rangeX = maxX - minX
-	rangeY = maxY - minY
+	mTop, mBottom, mLeft, mRight := r.widget.mTop, r.widget.mBottom, r.widget.mLeft, r.widget.mRight
+	widgetSize := r.scene.Size
 
-	plotAreaWidth := widgetSize.Width - mLeft - mRight
-	plotAreaHeight := widgetSize.Height - mTop - mBottom
+	// Compute derived series from their sources, once for the range and
+	// for drawing them
+	derivedPlots := r.widget.derivedPlots()
+
+	// Get the visible range (manual limits or padded data bounds)
+	minX, maxX, minY, maxY, err := r.widget.visibleRange(derivedPlots)
+	r.visible = err == nil
+	if err != nil {
+		r.view = r.widget.newView(r.scene.Size)
+		return
+	}
+
+	_, plotArea := r.widget.plotArea(r.scene.Size)
+	r.view = chartView{transform: newDataTransform(minX, maxX, minY, maxY, plotArea.Width, plotArea.Height, mLeft, mTop)}
+	plotAreaWidth := plotArea.Width
+	plotAreaHeight := plotArea.Height
 
 	// Draw chart title if present
 	if r.widget.ChartTitle != "" {
-		titleText := canvas.NewText(r.widget.ChartTitle, theme.ForegroundColor())
+		titleText := r.scene.newText(r.widget.ChartTitle, r.scene.Foreground)
 		titleText.TextSize = 16
 		titleText.TextStyle.Bold = true
 		titleText.Alignment = fyne.TextAlignCenter
 		titleWidth := titleText.MinSize().Width
 		titleText.Move(fyne.NewPos((widgetSize.Width-titleWidth)/2, 10))
-		r.objects = append(r.objects, titleText)
+		r.scene.addTo(layerLabels, titleText)
+	}
+
+	// Draw heatmaps first so grid lines stay visible over them
+	for _, heatmap := range r.widget.Heatmaps {
+		r.drawHeatmap(heatmap, minX, maxX, minY, maxY, plotAreaWidth, plotAreaHeight, mLeft, mTop)
+	}
+
+	for _, contour := range r.widget.Contours {
+		if contour.Filled {
+			r.drawContourFill(contour, minX, maxX, minY, maxY, plotAreaWidth, plotAreaHeight, mLeft, mTop)
+		}
+	}
+
+	// Draw reference bands behind the grid
+	for _, band := range r.widget.ReferenceBands {
+		r.drawReferenceBand(band, minX, maxX, minY, maxY, plotAreaWidth, plotAreaHeight, mLeft, mTop)
 	}
 
 	// Draw grid and axes
 	if r.widget.ShowGrid {
+		r.scene.setLayer(layerGrid)
 		r.drawGrid(minX, maxX, minY, maxY, plotAreaWidth, plotAreaHeight, mLeft, mTop)
 	}
 
+	r.scene.setLayer(layerAxes)
 	r.drawAxes(minX, maxX, minY, maxY, plotAreaWidth, plotAreaHeight, mLeft, mTop, mBottom)
+	r.scene.setLayer(layerSeries)
 
 	// Generate colors for plots
-	colors := r.generateColors(len(r.widget.Plots))
+	colors := generateColors(len(r.widget.Plots) + len(r.widget.BoxPlots) + len(r.widget.Waterfalls) + len(r.widget.Quivers) + len(r.widget.curveStyles()) + len(r.widget.DerivedSeries))
+
+	// Sample functions and curves for the visible range
+	curvePlots := r.curvePlots(minX, maxX, minY, maxY, plotAreaWidth, plotAreaHeight)
+	curveColorBase := len(r.widget.Plots) + len(r.widget.BoxPlots) + len(r.widget.Waterfalls) + len(r.widget.Quivers)
+
+	derivedColorBase := curveColorBase + len(curvePlots)
+
+	// Draw contour lines over the grid, behind the other series
+	for _, contour := range r.widget.Contours {
+		r.drawContourLines(contour, minX, maxX, minY, maxY, plotAreaWidth, plotAreaHeight, mLeft, mTop)
+	}
 
 	// Draw area fills first (so they appear behind everything)
 	for i, plot := range r.widget.Plots {
@@ -260,6 +355,56 @@ func (r *scatterChartRenderer) render() {
 		}
 	}
 
+	for i, plot := range curvePlots {
+		plotColor := colors[curveColorBase+i]
+		if plot.PlotColor != nil {
+			plotColor = plot.PlotColor
+		}
+
+		if plot.FillArea && !plot.FillToZero && plot.FillToPlotIdx < 0 {
+			r.drawClosedFill(plot, plotColor, minX, maxX, minY, maxY, plotAreaWidth, plotAreaHeight, mLeft, mTop)
+		} else if plot.FillArea {
+			r.drawAreaFill(-1, plot, plotColor, minX, maxX, minY, maxY, plotAreaWidth, plotAreaHeight, mLeft, mTop)
+		}
+	}
+
+	for i, plot := range derivedPlots {
+		plotColor := colors[derivedColorBase+i]
+		if plot.PlotColor != nil {
+			plotColor = plot.PlotColor
+		}
+
+		if plot.FillArea {
+			r.drawAreaFill(-1, plot, plotColor, minX, maxX, minY, maxY, plotAreaWidth, plotAreaHeight, mLeft, mTop)
+		}
+	}
+
+	// Draw box plots (behind lines and points, like bars)
+	for i, box := range r.widget.BoxPlots {
+		boxColor := colors[len(r.widget.Plots)+i]
+		if box.BoxColor != nil {
+			boxColor = box.BoxColor
+		}
+
+		r.drawBoxPlot(box, boxColor, minX, maxX, minY, maxY, plotAreaWidth, plotAreaHeight, mLeft, mTop)
+	}
+
+	// Draw waterfalls (bars, behind lines and points)
+	for i, waterfall := range r.widget.Waterfalls {
+		totalColor := colors[len(r.widget.Plots)+len(r.widget.BoxPlots)+i]
+		r.drawWaterfall(waterfall, totalColor, minX, maxX, minY, maxY, plotAreaWidth, plotAreaHeight, mLeft, mTop)
+	}
+
+	// Draw quivers
+	for i, quiver := range r.widget.Quivers {
+		quiverColor := colors[len(r.widget.Plots)+len(r.widget.BoxPlots)+len(r.widget.Waterfalls)+i]
+		if quiver.Color != nil {
+			quiverColor = quiver.Color
+		}
+
+		r.drawQuiver(quiver, quiverColor, minX, maxX, minY, maxY, plotAreaWidth, plotAreaHeight, mLeft, mTop)
+	}
+
 	// Draw each plot (lines and points on top of fills)
 	for i, plot := range r.widget.Plots {
 		plotColor := colors[i]
@@ -270,6 +415,35 @@ func (r *scatterChartRenderer) render() {
 		r.drawPlot(plot, plotColor, minX, maxX, minY, maxY, plotAreaWidth, plotAreaHeight, mLeft, mTop)
 	}
 
+	for i, plot := range curvePlots {
+		plotColor := colors[curveColorBase+i]
+		if plot.PlotColor != nil {
+			plotColor = plot.PlotColor
+		}
+
+		r.drawPlot(plot, plotColor, minX, maxX, minY, maxY, plotAreaWidth, plotAreaHeight, mLeft, mTop)
+	}
+
+	for i, plot := range derivedPlots {
+		plotColor := colors[derivedColorBase+i]
+		if plot.PlotColor != nil {
+			plotColor = plot.PlotColor
+		}
+
+		r.drawPlot(plot, plotColor, minX, maxX, minY, maxY, plotAreaWidth, plotAreaHeight, mLeft, mTop)
+	}
+
+	// Draw reference lines over the series
+	for _, line := range r.widget.ReferenceLines {
+		r.drawReferenceLine(line, minX, maxX, minY, maxY, plotAreaWidth, plotAreaHeight, mLeft, mTop)
+	}
+
+	// Draw trendlines over their series
+	r.drawTrendlines(colors, minX, maxX, minY, maxY, plotAreaWidth, plotAreaHeight, mLeft, mTop)
+
+	// Draw annotations over the series, below the legend
+	r.drawAnnotations(minX, maxX, minY, maxY, plotAreaWidth, plotAreaHeight, mLeft, mTop)
+
 	// Draw legend
 	if r.widget.ShowLegend && r.widget.LegendPosition != LegendNone {
 		r.drawLegend(colors, widgetSize.Width, widgetSize.Height, mLeft, mTop, mRight, mBottom)
@@ -277,30 +451,30 @@ func (r *scatterChartRenderer) render() {
 
 	// Draw axis titles if present
 	if r.widget.XAxisTitle != "" || r.widget.YAxisTitle != "" {
+		r.scene.setLayer(layerLabels)
 		r.drawAxisTitles(plotAreaWidth, plotAreaHeight, mLeft, mTop, mBottom, widgetSize.Width)
 	}
 
 	// Draw border
+	r.scene.setLayer(layerAxes)
 	r.drawBorder(plotAreaWidth, plotAreaHeight, mLeft, mTop)
 }
 
 // Draw a single plot
 func (r *scatterChartRenderer) drawPlot(plot Plot, plotColor color.Color, minX, maxX, minY, maxY, plotWidth, plotHeight, mLeft, mTop float32) {
+	// Thin large series to what the plot width can show, keeping the point
+	// domains of the full series
+	domains := plot.pointDomains()
+	plot = plot.downsampled(minX, maxX, plotWidth)
+
 	nodes := plot.Nodes
-	if len(nodes) == 0 {
+	if len(finiteNodes(nodes)) == 0 {
 		return
 	}
 
-	rangeX := maxX - minX
-	rangeY := maxY - minY
-
-	// Transform function from data coordinates to screen coordinates
-	dataToScreenX := func(x float32) float32 {
-		return mLeft + ((x-minX)/rangeX)*plotWidth
-	}
-	dataToScreenY := func(y float32) float32 {
-		return mTop + plotHeight - ((y-minY)/rangeY)*plotHeight
-	}
+	// Transform functions from data coordinates to screen coordinates
+	transform := newDataTransform(minX, maxX, minY, maxY, plotWidth, plotHeight, mLeft, mTop)
+	dataToScreenX, dataToScreenY := transform.x, transform.y
 
 	// Draw bars first (so they appear behind lines and points)
 	if plot.ShowBars {
@@ -308,42 +482,52 @@ func (r *scatterChartRenderer) drawPlot(plot Plot, plotColor color.Color, minX,
 	}
 
 	// Draw lines (so they appear behind points)
-	if plot.ShowLine && len(nodes) > 1 {
-		for k := 0; k < len(nodes)-1; k++ {
-			x1 := dataToScreenX(nodes[k].X)
-			y1 := dataToScreenY(nodes[k].Y)
-			x2 := dataToScreenX(nodes[k+1].X)
-			y2 := dataToScreenY(nodes[k+1].Y)
-
-			line := canvas.NewLine(plotColor)
-			line.StrokeWidth = plot.LineWidth
-			line.Position1 = fyne.NewPos(x1, y1)
-			line.Position2 = fyne.NewPos(x2, y2)
-			r.objects = append(r.objects, line)
+	// Lines break at missing values
+	if plot.ShowLine {
+		pattern := dashPattern(plot.LineStyle, plot.DashPattern, plot.LineWidth)
+		for _, run := range plot.lineRuns() {
+			points := make([]fyne.Position, len(run))
+			for k, node := range run {
+				points[k] = fyne.NewPos(dataToScreenX(node.X), dataToScreenY(node.Y))
+			}
+
+			r.drawPolyline(points, plotColor, plot.LineWidth, pattern)
 		}
 	}
 
 	// Draw points
 	if plot.ShowPoints {
-		for j := 0; j < len(nodes); j++ {
-			x := dataToScreenX(nodes[j].X)
-			y := dataToScreenY(nodes[j].Y)
-
-			circle := canvas.NewCircle(plotColor)
-			circle.FillColor = plotColor
-			circle.StrokeColor = plotColor
-			circle.StrokeWidth = 1
-
-			radius := plot.PointSize
-			circle.Resize(fyne.NewSize(radius*2, radius*2))
-			circle.Move(fyne.NewPos(x-radius, y-radius))
-			r.objects = append(r.objects, circle)
-		}
+		r.drawPoints(plot, domains, plotColor, func(n Node) fyne.Position {
+			return fyne.NewPos(dataToScreenX(n.X), dataToScreenY(n.Y))
+		})
 	}
 
 	// Draw data labels if enabled
 	if plot.ShowDataLabels {
-		r.drawDataLabels(plot, nodes, dataToScreenX, dataToScreenY)
+		r.drawDataLabels(plot, finiteNodes(nodes), dataToScreenX, dataToScreenY)
+	}
+}
+
+// Draw the markers of a plot at the screen positions given by toScreen, with
+// sizes and colours scaled over domains
+func (r *scatterChartRenderer) drawPoints(plot Plot, domains pointDomains, plotColor color.Color, toScreen func(Node) fyne.Position) {
+	nodes := plot.Nodes
+
+	for _, j := range plot.pointOrder() {
+		if !isFiniteNode(nodes[j]) {
+			continue
+		}
+
+		pos := toScreen(nodes[j])
+
+		pointColor := plotColor
+		if plot.ColorByValue && domains.colorOK {
+			pointColor = plot.ColorScale.colorAt(nodes[j].Value, domains.colorLo, domains.colorHi)
+		}
+
+		fill, stroke := plot.markerColors(pointColor)
+		radius := plot.pointRadius(nodes[j], domains.sizeLo, domains.sizeHi)
+		r.drawMarker(plot.Marker, pos.X, pos.Y, radius, fill, stroke, plot.MarkerStrokeWidth)
 	}
 }
 
@@ -351,7 +535,7 @@ func (r *scatterChartRenderer) drawPlot(plot Plot, plotColor color.Color, minX,
 func (r *scatterChartRenderer) drawDataLabels(plot Plot, nodes []Node, dataToScreenX, dataToScreenY func(float32) float32) {
 	labelColor := plot.LabelColor
 	if labelColor == nil {
-		labelColor = theme.ForegroundColor()
+		labelColor = r.scene.Foreground
 	}
 
 	labelSize := plot.LabelSize
@@ -370,7 +554,7 @@ func (r *scatterChartRenderer) drawDataLabels(plot Plot, nodes []Node, dataToScr
 
 		// Format the label text
 		labelText := fmt.Sprintf(labelFormat, node.Y)
-		label := canvas.NewText(labelText, labelColor)
+		label := r.scene.newText(labelText, labelColor)
 		label.TextSize = labelSize
 
 		labelWidth := label.MinSize().Width
@@ -393,13 +577,13 @@ func (r *scatterChartRenderer) drawDataLabels(plot Plot, nodes []Node, dataToScr
 		}
 
 		label.Move(fyne.NewPos(labelX, labelY))
-		r.objects = append(r.objects, label)
+		r.scene.add(label)
 	}
 }
 
 // Draw bars for a bar chart
 func (r *scatterChartRenderer) drawBars(plot Plot, plotColor color.Color, minX, maxX, minY, maxY, plotWidth, plotHeight, mLeft, mTop float32, dataToScreenX, dataToScreenY func(float32) float32) {
-	nodes := plot.Nodes
+	nodes := finiteNodes(plot.Nodes)
 	if len(nodes) == 0 {
 		return
 	}
@@ -462,88 +646,93 @@ func (r *scatterChartRenderer) drawBars(plot Plot, plotColor color.Color, minX,
 			continue
 		}
 
-		// Create the bar rectangle
-		bar := canvas.NewRectangle(plotColor)
-		bar.Move(fyne.NewPos(barX, barY))
-		bar.Resize(fyne.NewSize(barWidthScreen, barHeight))
-		r.objects = append(r.objects, bar)
-
-		// Draw border if specified
-		if plot.BarBorderWidth > 0 {
-			borderColor := plot.BarBorderColor
-			if borderColor == nil {
-				// Default to darker version of bar color
-				if rgba, ok := plotColor.(color.RGBA); ok {
-					borderColor = color.RGBA{
-						R: uint8(float32(rgba.R) * 0.7),
-						G: uint8(float32(rgba.G) * 0.7),
-						B: uint8(float32(rgba.B) * 0.7),
-						A: rgba.A,
-					}
-				} else {
-					borderColor = plotColor
+		r.drawBar(barX, barY, barWidthScreen, barHeight, plotColor, plot.BarBorderWidth, plot.BarBorderColor)
+	}
+}
+
+// Draw a single bar rectangle with an optional border
+func (r *scatterChartRenderer) drawBar(barX, barY, barWidthScreen, barHeight float32, plotColor color.Color, borderWidth float32, borderColor color.Color) {
+	// Create the bar rectangle
+	bar := r.scene.newRectangle(plotColor)
+	bar.Move(fyne.NewPos(barX, barY))
+	bar.Resize(fyne.NewSize(barWidthScreen, barHeight))
+	r.scene.add(bar)
+
+	// Draw border if specified
+	if borderWidth > 0 {
+		if borderColor == nil {
+			// Default to darker version of bar color
+			if rgba, ok := plotColor.(color.RGBA); ok {
+				borderColor = color.RGBA{
+					R: uint8(float32(rgba.R) * 0.7),
+					G: uint8(float32(rgba.G) * 0.7),
+					B: uint8(float32(rgba.B) * 0.7),
+					A: rgba.A,
 				}
+			} else {
+				borderColor = plotColor
 			}
-
-			// Draw four border lines
-			// Top
-			topLine := canvas.NewLine(borderColor)
-			topLine.StrokeWidth = plot.BarBorderWidth
-			topLine.Position1 = fyne.NewPos(barX, barY)
-			topLine.Position2 = fyne.NewPos(barX+barWidthScreen, barY)
-			r.objects = append(r.objects, topLine)
-
-			// Bottom
-			bottomLine := canvas.NewLine(borderColor)
-			bottomLine.StrokeWidth = plot.BarBorderWidth
-			bottomLine.Position1 = fyne.NewPos(barX, barY+barHeight)
-			bottomLine.Position2 = fyne.NewPos(barX+barWidthScreen, barY+barHeight)
-			r.objects = append(r.objects, bottomLine)
-
-			// Left
-			leftLine := canvas.NewLine(borderColor)
-			leftLine.StrokeWidth = plot.BarBorderWidth
-			leftLine.Position1 = fyne.NewPos(barX, barY)
-			leftLine.Position2 = fyne.NewPos(barX, barY+barHeight)
-			r.objects = append(r.objects, leftLine)
-
-			// Right
-			rightLine := canvas.NewLine(borderColor)
-			rightLine.StrokeWidth = plot.BarBorderWidth
-			rightLine.Position1 = fyne.NewPos(barX+barWidthScreen, barY)
-			rightLine.Position2 = fyne.NewPos(barX+barWidthScreen, barY+barHeight)
-			r.objects = append(r.objects, rightLine)
 		}
+
+		// Draw four border lines
+		// Top
+		topLine := r.scene.newLine(borderColor)
+		topLine.StrokeWidth = borderWidth
+		topLine.Position1 = fyne.NewPos(barX, barY)
+		topLine.Position2 = fyne.NewPos(barX+barWidthScreen, barY)
+		r.scene.add(topLine)
+
+		// Bottom
+		bottomLine := r.scene.newLine(borderColor)
+		bottomLine.StrokeWidth = borderWidth
+		bottomLine.Position1 = fyne.NewPos(barX, barY+barHeight)
+		bottomLine.Position2 = fyne.NewPos(barX+barWidthScreen, barY+barHeight)
+		r.scene.add(bottomLine)
+
+		// Left
+		leftLine := r.scene.newLine(borderColor)
+		leftLine.StrokeWidth = borderWidth
+		leftLine.Position1 = fyne.NewPos(barX, barY)
+		leftLine.Position2 = fyne.NewPos(barX, barY+barHeight)
+		r.scene.add(leftLine)
+
+		// Right
+		rightLine := r.scene.newLine(borderColor)
+		rightLine.StrokeWidth = borderWidth
+		rightLine.Position1 = fyne.NewPos(barX+barWidthScreen, barY)
+		rightLine.Position2 = fyne.NewPos(barX+barWidthScreen, barY+barHeight)
+		r.scene.add(rightLine)
 	}
 }
 
 // Draw area fill for a plot using smooth polygon rendering
 func (r *scatterChartRenderer) drawAreaFill(plotIdx int, plot Plot, plotColor color.Color, minX, maxX, minY, maxY, plotWidth, plotHeight, mLeft, mTop float32) {
-	nodes := plot.Nodes
-	if len(nodes) < 2 {
+	// Fills break wherever the line breaks
+	plot = plot.downsampled(minX, maxX, plotWidth)
+	runs := plot.lineRuns()
+	if len(runs) == 0 {
 		return
 	}
 
-	rangeX := maxX - minX
-	rangeY := maxY - minY
-
-	// Transform function from data coordinates to screen coordinates
-	dataToScreenX := func(x float32) float32 {
-		return mLeft + ((x-minX)/rangeX)*plotWidth
-	}
-	dataToScreenY := func(y float32) float32 {
-		return mTop + plotHeight - ((y-minY)/rangeY)*plotHeight
-	}
+	// Transform functions from data coordinates to screen coordinates
+	transform := newDataTransform(minX, maxX, minY, maxY, plotWidth, plotHeight, mLeft, mTop)
+	dataToScreenX, dataToScreenY := transform.x, transform.y
 
 	// Determine fill color (use custom or derive from plot color with transparency)
 	fillColor := plot.FillColor
 	if fillColor == nil {
-		// Use plot color with 30% opacity
-		if rgba, ok := plotColor.(color.RGBA); ok {
-			fillColor = color.RGBA{R: rgba.R, G: rgba.G, B: rgba.B, A: 76} // 76 = 30% of 255
-		} else {
-			r, g, b, _ := plotColor.RGBA()
-			fillColor = color.RGBA{R: uint8(r >> 8), G: uint8(g >> 8), B: uint8(b >> 8), A: 76}
+		fillColor = translucent(plotColor)
+	}
+
+	toScreen := func(n Node) fyne.Position {
+		return fyne.NewPos(dataToScreenX(n.X), dataToScreenY(n.Y))
+	}
+
+	// Each area is its own polygon, clipped to the plot area
+	fillPath := func(path []fyne.Position) {
+		path = clipPolygon(path, mLeft, mTop, mLeft+plotWidth, mTop+plotHeight)
+		if len(path) >= 3 {
+			r.scene.add(newPolygon([][]fyne.Position{path}, fillColor))
 		}
 	}
 
@@ -558,85 +747,66 @@ func (r *scatterChartRenderer) drawAreaFill(plotIdx int, plot Plot, plotColor co
 			zeroY = mTop + plotHeight
 		}
 
-		// Draw vertical rectangles from curve to zero line
-		// Sample at many points for smoothness
-		steps := 500
-		for step := 0; step < steps; step++ {
-			t := float32(step) / float32(steps-1)
-			dataX := nodes[0].X + t*(nodes[len(nodes)-1].X-nodes[0].X)
-			dataY := interpolateY(nodes, dataX)
-
-			screenX := dataToScreenX(dataX)
-			screenY := dataToScreenY(dataY)
-
-			// Draw thin vertical rectangle from curve to zero
-			rectY1 := screenY
-			rectY2 := zeroY
-			if rectY1 > rectY2 {
-				rectY1, rectY2 = rectY2, rectY1
+		for _, run := range runs {
+			if len(run) < 2 {
+				continue
 			}
 
-			rectHeight := rectY2 - rectY1
-			if rectHeight > 0 {
-				rect := canvas.NewRectangle(fillColor)
-				rect.Move(fyne.NewPos(screenX, rectY1))
-				rect.Resize(fyne.NewSize(plotWidth/float32(steps)+0.5, rectHeight))
-				r.objects = append(r.objects, rect)
+			// Along the curve, then back along the baseline
+			path := make([]fyne.Position, 0, len(run)+2)
+			for _, n := range run {
+				path = append(path, toScreen(n))
 			}
+			path = append(path,
+				fyne.NewPos(dataToScreenX(run[len(run)-1].X), zeroY),
+				fyne.NewPos(dataToScreenX(run[0].X), zeroY),
+			)
+			fillPath(path)
 		}
 	}
 
 	// Fill between two plots
 	if plot.FillToPlotIdx >= 0 && plot.FillToPlotIdx < len(r.widget.Plots) {
-		otherPlot := r.widget.Plots[plot.FillToPlotIdx]
-		otherNodes := otherPlot.Nodes
-
-		if len(otherNodes) < 2 {
-			return
-		}
+		otherRuns := r.widget.Plots[plot.FillToPlotIdx].downsampled(minX, maxX, plotWidth).lineRuns()
 
-		// Find common X range
-		minCommonX := math.Max(float64(nodes[0].X), float64(otherNodes[0].X))
-		maxCommonX := math.Min(float64(nodes[len(nodes)-1].X), float64(otherNodes[len(otherNodes)-1].X))
+		for _, run := range runs {
+			for _, otherRun := range otherRuns {
+				if len(run) < 2 || len(otherRun) < 2 {
+					continue
+				}
 
-		if minCommonX >= maxCommonX {
-			return
-		}
+				// Find common X range
+				minCommonX := float32(math.Max(float64(run[0].X), float64(otherRun[0].X)))
+				maxCommonX := float32(math.Min(float64(run[len(run)-1].X), float64(otherRun[len(otherRun)-1].X)))
 
-		// Draw vertical rectangles between the two curves
-		// Sample at many points for smoothness
-		steps := 500
-		for step := 0; step < steps; step++ {
-			t := float32(step) / float32(steps-1)
-			dataX := float32(minCommonX) + t*float32(maxCommonX-minCommonX)
-
-			// Get Y values for both curves at this X
-			dataY1 := interpolateY(nodes, dataX)
-			dataY2 := interpolateY(otherNodes, dataX)
-
-			// Convert to screen coordinates
-			screenX := dataToScreenX(dataX)
-			screenY1 := dataToScreenY(dataY1)
-			screenY2 := dataToScreenY(dataY2)
-
-			// Draw thin vertical rectangle between the two curves
-			rectY1 := screenY1
-			rectY2 := screenY2
-			if rectY1 > rectY2 {
-				rectY1, rectY2 = rectY2, rectY1
-			}
+				if minCommonX >= maxCommonX {
+					continue
+				}
 
-			rectHeight := rectY2 - rectY1
-			if rectHeight > 0 {
-				rect := canvas.NewRectangle(fillColor)
-				rect.Move(fyne.NewPos(screenX, rectY1))
-				rect.Resize(fyne.NewSize(plotWidth/float32(steps)+0.5, rectHeight))
-				r.objects = append(r.objects, rect)
+				// Along this curve, then back along the other one
+				upper := clipRun(run, minCommonX, maxCommonX)
+				lower := clipRun(otherRun, minCommonX, maxCommonX)
+				path := make([]fyne.Position, 0, len(upper)+len(lower))
+				for _, n := range upper {
+					path = append(path, toScreen(n))
+				}
+				for k := len(lower) - 1; k >= 0; k-- {
+					path = append(path, toScreen(lower[k]))
+				}
+				fillPath(path)
 			}
 		}
 	}
 }
 
+// translucent returns c at the 30% opacity used for default fills.
+func translucent(c color.Color) color.Color {
+	// Non-premultiplied, so the color keeps its hue at any opacity
+	nrgba := color.NRGBAModel.Convert(c).(color.NRGBA)
+	nrgba.A = 76 // 76 = 30% of 255
+	return nrgba
+}
+
 // Interpolate Y value for a given X in a set of nodes
 func interpolateY(nodes []Node, x float32) float32 {
 	if len(nodes) == 0 {
@@ -671,6 +841,7 @@ func (r *scatterChartRenderer) drawGrid(minX, maxX, minY, maxY, plotWidth, plotH
 
 	rangeX := maxX - minX
 	rangeY := maxY - minY
+	transform := newDataTransform(minX, maxX, minY, maxY, plotWidth, plotHeight, mLeft, mTop)
 
 	// Calculate nice tick intervals
 	numXTicks := int(plotWidth / minTickSpacing)
@@ -689,31 +860,32 @@ func (r *scatterChartRenderer) drawGrid(minX, maxX, minY, maxY, plotWidth, plotH
 	// Draw vertical grid lines
 	xStart := math.Ceil(float64(minX/xTickInterval)) * float64(xTickInterval)
 	for x := float32(xStart); x <= maxX; x += xTickInterval {
-		screenX := mLeft + ((x-minX)/rangeX)*plotWidth
-		line := canvas.NewLine(gridColor)
+		screenX := transform.x(x)
+		line := r.scene.newLine(gridColor)
 		line.StrokeWidth = gridLineWidth
 		line.Position1 = fyne.NewPos(screenX, mTop)
 		line.Position2 = fyne.NewPos(screenX, mTop+plotHeight)
-		r.objects = append(r.objects, line)
+		r.scene.add(line)
 	}
 
 	// Draw horizontal grid lines
 	yStart := math.Ceil(float64(minY/yTickInterval)) * float64(yTickInterval)
 	for y := float32(yStart); y <= maxY; y += yTickInterval {
-		screenY := mTop + plotHeight - ((y-minY)/rangeY)*plotHeight
-		line := canvas.NewLine(gridColor)
+		screenY := transform.y(y)
+		line := r.scene.newLine(gridColor)
 		line.StrokeWidth = gridLineWidth
 		line.Position1 = fyne.NewPos(mLeft, screenY)
 		line.Position2 = fyne.NewPos(mLeft+plotWidth, screenY)
-		r.objects = append(r.objects, line)
+		r.scene.add(line)
 	}
 }
 
 // Draw axes with labels
 func (r *scatterChartRenderer) drawAxes(minX, maxX, minY, maxY, plotWidth, plotHeight, mLeft, mTop, mBottom float32) {
-	foregroundColor := theme.ForegroundColor()
+	foregroundColor := r.scene.Foreground
 	rangeX := maxX - minX
 	rangeY := maxY - minY
+	transform := newDataTransform(minX, maxX, minY, maxY, plotWidth, plotHeight, mLeft, mTop)
 
 	// Calculate tick intervals
 	numXTicks := int(plotWidth / minTickSpacing)
@@ -733,69 +905,69 @@ func (r *scatterChartRenderer) drawAxes(minX, maxX, minY, maxY, plotWidth, plotH
 	xAxisY := mTop + plotHeight
 	if minY < 0 && maxY > 0 {
 		// Zero line is visible
-		xAxisY = mTop + plotHeight - ((-minY)/rangeY)*plotHeight
+		xAxisY = transform.y(0)
 	}
 
-	xAxis := canvas.NewLine(foregroundColor)
+	xAxis := r.scene.newLine(foregroundColor)
 	xAxis.StrokeWidth = axisLineWidth
 	xAxis.Position1 = fyne.NewPos(mLeft, xAxisY)
 	xAxis.Position2 = fyne.NewPos(mLeft+plotWidth, xAxisY)
-	r.objects = append(r.objects, xAxis)
+	r.scene.add(xAxis)
 
 	// Draw X axis ticks and labels
 	xStart := math.Ceil(float64(minX/xTickInterval)) * float64(xTickInterval)
 	for x := float32(xStart); x <= maxX; x += xTickInterval {
-		screenX := mLeft + ((x-minX)/rangeX)*plotWidth
+		screenX := transform.x(x)
 
 		// Tick mark
-		tick := canvas.NewLine(foregroundColor)
+		tick := r.scene.newLine(foregroundColor)
 		tick.StrokeWidth = axisLineWidth
 		tick.Position1 = fyne.NewPos(screenX, xAxisY)
 		tick.Position2 = fyne.NewPos(screenX, xAxisY+tickLength)
-		r.objects = append(r.objects, tick)
+		r.scene.add(tick)
 
 		// Label
 		labelText := formatAxisLabel(x)
-		label := canvas.NewText(labelText, foregroundColor)
+		label := r.scene.newText(labelText, foregroundColor)
 		label.TextSize = 10
 		labelWidth := label.MinSize().Width
 		label.Move(fyne.NewPos(screenX-labelWidth/2, xAxisY+tickLength+2))
-		r.objects = append(r.objects, label)
+		r.scene.addTo(layerLabels, label)
 	}
 
 	// Draw Y axis
 	yAxisX := mLeft
 	if minX < 0 && maxX > 0 {
 		// Zero line is visible
-		yAxisX = mLeft + ((-minX)/rangeX)*plotWidth
+		yAxisX = transform.x(0)
 	}
 
-	yAxis := canvas.NewLine(foregroundColor)
+	yAxis := r.scene.newLine(foregroundColor)
 	yAxis.StrokeWidth = axisLineWidth
 	yAxis.Position1 = fyne.NewPos(yAxisX, mTop)
 	yAxis.Position2 = fyne.NewPos(yAxisX, mTop+plotHeight)
-	r.objects = append(r.objects, yAxis)
+	r.scene.add(yAxis)
 
 	// Draw Y axis ticks and labels
 	yStart := math.Ceil(float64(minY/yTickInterval)) * float64(yTickInterval)
 	for y := float32(yStart); y <= maxY; y += yTickInterval {
-		screenY := mTop + plotHeight - ((y-minY)/rangeY)*plotHeight
+		screenY := transform.y(y)
 
 		// Tick mark
-		tick := canvas.NewLine(foregroundColor)
+		tick := r.scene.newLine(foregroundColor)
 		tick.StrokeWidth = axisLineWidth
 		tick.Position1 = fyne.NewPos(yAxisX-tickLength, screenY)
 		tick.Position2 = fyne.NewPos(yAxisX, screenY)
-		r.objects = append(r.objects, tick)
+		r.scene.add(tick)
 
 		// Label
 		labelText := formatAxisLabel(y)
-		label := canvas.NewText(labelText, foregroundColor)
+		label := r.scene.newText(labelText, foregroundColor)
 		label.TextSize = 10
 		labelWidth := label.MinSize().Width
 		labelHeight := label.MinSize().Height
 		label.Move(fyne.NewPos(yAxisX-tickLength-labelWidth-5, screenY-labelHeight/2))
-		r.objects = append(r.objects, label)
+		r.scene.addTo(layerLabels, label)
 	}
 
 	// Draw axis arrows
@@ -804,65 +976,60 @@ func (r *scatterChartRenderer) drawAxes(minX, maxX, minY, maxY, plotWidth, plotH
 	// X axis arrow
 	xArrowY := xAxisY
 	xArrowTip := mLeft + plotWidth
-	xArrow1 := canvas.NewLine(foregroundColor)
-	xArrow1.StrokeWidth = axisLineWidth
-	xArrow1.Position1 = fyne.NewPos(xArrowTip, xArrowY)
-	xArrow1.Position2 = fyne.NewPos(xArrowTip-arrowSize, xArrowY-arrowSize/2)
-	r.objects = append(r.objects, xArrow1)
-
-	xArrow2 := canvas.NewLine(foregroundColor)
-	xArrow2.StrokeWidth = axisLineWidth
-	xArrow2.Position1 = fyne.NewPos(xArrowTip, xArrowY)
-	xArrow2.Position2 = fyne.NewPos(xArrowTip-arrowSize, xArrowY+arrowSize/2)
-	r.objects = append(r.objects, xArrow2)
+	r.drawArrowhead(fyne.NewPos(xArrowTip, xArrowY), 1, 0, arrowSize, axisLineWidth, foregroundColor)
 
 	// Y axis arrow
 	yArrowX := yAxisX
 	yArrowTip := mTop
-	yArrow1 := canvas.NewLine(foregroundColor)
-	yArrow1.StrokeWidth = axisLineWidth
-	yArrow1.Position1 = fyne.NewPos(yArrowX, yArrowTip)
-	yArrow1.Position2 = fyne.NewPos(yArrowX-arrowSize/2, yArrowTip+arrowSize)
-	r.objects = append(r.objects, yArrow1)
-
-	yArrow2 := canvas.NewLine(foregroundColor)
-	yArrow2.StrokeWidth = axisLineWidth
-	yArrow2.Position1 = fyne.NewPos(yArrowX, yArrowTip)
-	yArrow2.Position2 = fyne.NewPos(yArrowX+arrowSize/2, yArrowTip+arrowSize)
-	r.objects = append(r.objects, yArrow2)
+	r.drawArrowhead(fyne.NewPos(yArrowX, yArrowTip), 0, -1, arrowSize, axisLineWidth, foregroundColor)
 
 	// Axis labels (X and Y markers)
-	xLabel := canvas.NewText("X", foregroundColor)
+	xLabel := r.scene.newText("X", foregroundColor)
 	xLabel.TextSize = 14
 	xLabel.TextStyle.Bold = true
 	xLabel.Move(fyne.NewPos(xArrowTip+5, xArrowY-xLabel.MinSize().Height/2))
-	r.objects = append(r.objects, xLabel)
+	r.scene.addTo(layerLabels, xLabel)
 
-	yLabel := canvas.NewText("Y", foregroundColor)
+	yLabel := r.scene.newText("Y", foregroundColor)
 	yLabel.TextSize = 14
 	yLabel.TextStyle.Bold = true
 	yLabel.Move(fyne.NewPos(yArrowX-yLabel.MinSize().Width/2, yArrowTip-yLabel.MinSize().Height-5))
-	r.objects = append(r.objects, yLabel)
+	r.scene.addTo(layerLabels, yLabel)
+}
+
+// Draw an open arrowhead at tip pointing along the unit screen direction
+// (dirX, dirY), size pixels long and half as wide
+func (r *scatterChartRenderer) drawArrowhead(tip fyne.Position, dirX, dirY, size, width float32, arrowColor color.Color) {
+	backX, backY := tip.X-dirX*size, tip.Y-dirY*size
+	perpX, perpY := -dirY*size/2, dirX*size/2
+
+	for _, side := range []float32{-1, 1} {
+		line := r.scene.newLine(arrowColor)
+		line.StrokeWidth = width
+		line.Position1 = tip
+		line.Position2 = fyne.NewPos(backX+side*perpX, backY+side*perpY)
+		r.scene.add(line)
+	}
 }
 
 // Draw axis titles
 func (r *scatterChartRenderer) drawAxisTitles(plotWidth, plotHeight, mLeft, mTop, mBottom, widgetWidth float32) {
-	foregroundColor := theme.ForegroundColor()
+	foregroundColor := r.scene.Foreground
 
 	// X-axis title (centered below the plot)
 	if r.widget.XAxisTitle != "" {
-		xTitle := canvas.NewText(r.widget.XAxisTitle, foregroundColor)
+		xTitle := r.scene.newText(r.widget.XAxisTitle, foregroundColor)
 		xTitle.TextSize = 12
 		xTitle.TextStyle.Bold = true
 		xTitle.Alignment = fyne.TextAlignCenter
 		titleWidth := xTitle.MinSize().Width
 		xTitle.Move(fyne.NewPos(mLeft+(plotWidth-titleWidth)/2, mTop+plotHeight+mBottom-25))
-		r.objects = append(r.objects, xTitle)
+		r.scene.add(xTitle)
 	}
 
 	// Y-axis title (rotated 90 degrees, centered on left side)
 	if r.widget.YAxisTitle != "" {
-		yTitle := canvas.NewText(r.widget.YAxisTitle, foregroundColor)
+		yTitle := r.scene.newText(r.widget.YAxisTitle, foregroundColor)
 		yTitle.TextSize = 12
 		yTitle.TextStyle.Bold = true
 		titleHeight := yTitle.MinSize().Height
@@ -870,171 +1037,213 @@ func (r *scatterChartRenderer) drawAxisTitles(plotWidth, plotHeight, mLeft, mTop
 		yTitle.Move(fyne.NewPos(15, mTop+(plotHeight+titleHeight)/2))
 		// Note: Fyne doesn't support text rotation easily, so this will be horizontal
 		// For a production library, you'd use a custom renderer with rotation
-		r.objects = append(r.objects, yTitle)
+		r.scene.add(yTitle)
 	}
 }
 
 // Draw legend with support for different positions
 func (r *scatterChartRenderer) drawLegend(colors []color.Color, widgetWidth, widgetHeight, mLeft, mTop, mRight, mBottom float32) {
-	if len(r.widget.Plots) == 0 {
-		return
-	}
+	horizontal := r.widget.LegendPosition == LegendBottom || r.widget.LegendPosition == LegendTop
+	entries := r.legendEntries(colors)
+	blocks := r.legendBlocks(colors, horizontal)
 
-	foregroundColor := theme.ForegroundColor()
+	drawLegendLayout(r.widget.LegendPosition, r.widget.ChartTitle != "", entries, blocks, widgetWidth, widgetHeight, mTop, mRight, mBottom, r.scene)
+}
 
-	// Calculate legend dimensions
-	itemHeight := float32(20)
-	titleHeight := float32(18)
-	numItems := len(r.widget.Plots)
+// legendEntries returns one draw function per legend row, in series order.
+func (r *scatterChartRenderer) legendEntries(colors []color.Color) []func(x, y float32) {
+	entries := []func(x, y float32){}
 
-	// Determine position based on LegendPosition
-	var x, y float32
+	for i, plot := range r.widget.Plots {
+		plot := plot
+		plotColor := colors[i]
+		if plot.PlotColor != nil {
+			plotColor = plot.PlotColor
+		}
 
-	switch r.widget.LegendPosition {
-	case LegendRight:
-		x = widgetWidth - mRight
-		y = mTop
+		entries = append(entries, func(x, y float32) {
+			r.drawLegendItem(plot, plotColor, x, y)
+		})
+	}
 
-		legendTitle := canvas.NewText("LEGEND", foregroundColor)
-		legendTitle.TextSize = 11
-		legendTitle.TextStyle.Bold = true
-		legendTitle.Move(fyne.NewPos(x+5, y))
-		r.objects = append(r.objects, legendTitle)
+	// Polar charts only draw Plots
+	if r.widget.Polar {
+		return entries
+	}
 
-		currentY := y + titleHeight
+	for i, box := range r.widget.BoxPlots {
+		box := box
+		boxColor := colors[len(r.widget.Plots)+i]
+		if box.BoxColor != nil {
+			boxColor = box.BoxColor
+		}
 
-		for i, plot := range r.widget.Plots {
-			plotColor := colors[i]
-			if plot.PlotColor != nil {
-				plotColor = plot.PlotColor
-			}
+		entries = append(entries, func(x, y float32) {
+			r.drawBoxLegendItem(box, boxColor, x, y)
+		})
+	}
+
+	for i, waterfall := range r.widget.Waterfalls {
+		waterfall := waterfall
+		totalColor := colors[len(r.widget.Plots)+len(r.widget.BoxPlots)+i]
 
-			r.drawLegendItem(plot, plotColor, x, currentY)
-			currentY += itemHeight
+		entries = append(entries, func(x, y float32) {
+			r.drawWaterfallLegendItem(waterfall, totalColor, x, y)
+		})
+	}
+
+	for i, quiver := range r.widget.Quivers {
+		quiver := quiver
+		quiverColor := colors[len(r.widget.Plots)+len(r.widget.BoxPlots)+len(r.widget.Waterfalls)+i]
+		if quiver.Color != nil {
+			quiverColor = quiver.Color
 		}
 
-	case LegendBottom:
-		// Center legend at bottom
-		itemWidth := float32(120)
-		legendWidth := float32(numItems) * itemWidth
-		x = (widgetWidth - legendWidth) / 2
-		y = widgetHeight - mBottom + 20
-
-		for i, plot := range r.widget.Plots {
-			plotColor := colors[i]
-			if plot.PlotColor != nil {
-				plotColor = plot.PlotColor
-			}
+		entries = append(entries, func(x, y float32) {
+			r.drawQuiverLegendItem(quiver, quiverColor, x, y)
+		})
+	}
 
-			itemX := x + float32(i)*itemWidth
-			r.drawLegendItem(plot, plotColor, itemX, y)
+	for i, plot := range r.widget.curveStyles() {
+		plot := plot
+		plotColor := colors[len(r.widget.Plots)+len(r.widget.BoxPlots)+len(r.widget.Waterfalls)+len(r.widget.Quivers)+i]
+		if plot.PlotColor != nil {
+			plotColor = plot.PlotColor
 		}
 
-	case LegendTop:
-		// Center legend at top (below title if present)
-		itemWidth := float32(120)
-		legendWidth := float32(numItems) * itemWidth
-		x = (widgetWidth - legendWidth) / 2
-		y = float32(30)
-		if r.widget.ChartTitle != "" {
-			y = 35
+		entries = append(entries, func(x, y float32) {
+			r.drawLegendItem(plot, plotColor, x, y)
+		})
+	}
+
+	for i, plot := range r.widget.DerivedSeries {
+		plot := plot.Plot
+		plotColor := colors[len(r.widget.Plots)+len(r.widget.BoxPlots)+len(r.widget.Waterfalls)+len(r.widget.Quivers)+len(r.widget.curveStyles())+i]
+		if plot.PlotColor != nil {
+			plotColor = plot.PlotColor
 		}
 
-		for i, plot := range r.widget.Plots {
-			plotColor := colors[i]
-			if plot.PlotColor != nil {
-				plotColor = plot.PlotColor
-			}
+		entries = append(entries, func(x, y float32) {
+			r.drawLegendItem(plot, plotColor, x, y)
+		})
+	}
+
+	return entries
+}
 
-			itemX := x + float32(i)*itemWidth
-			r.drawLegendItem(plot, plotColor, itemX, y)
+// legendBlocks returns the colour bars and keys drawn after the legend rows.
+// Horizontal legends lay their blocks out side by side.
+func (r *scatterChartRenderer) legendBlocks(colors []color.Color, horizontal bool) []legendBlock {
+	blocks := []legendBlock{}
+
+	// Polar charts only draw Plots
+	heatmaps, contours, quivers := r.widget.Heatmaps, r.widget.Contours, r.widget.Quivers
+	if r.widget.Polar {
+		heatmaps, contours, quivers = nil, nil, nil
+	}
+
+	for _, heatmap := range heatmaps {
+		if !heatmap.ShowColorBar || !heatmap.valid() {
+			continue
 		}
+		lo, hi, ok := heatmap.colorDomain()
+		if !ok {
+			continue
+		}
+		blocks = append(blocks, r.colorBarBlock(heatmap.Scale, lo, hi, heatmap.Title, horizontal))
+	}
 
-	case LegendLeft:
-		x = float32(10)
-		y = mTop
+	for _, contour := range contours {
+		if !contour.ShowColorBar || !contour.valid() {
+			continue
+		}
+		lo, hi, ok := contour.colorDomain()
+		if !ok {
+			continue
+		}
+		blocks = append(blocks, r.colorBarBlock(contour.Scale, lo, hi, contour.Title, horizontal))
+	}
 
-		legendTitle := canvas.NewText("LEGEND", foregroundColor)
-		legendTitle.TextSize = 11
-		legendTitle.TextStyle.Bold = true
-		legendTitle.Move(fyne.NewPos(x+5, y))
-		r.objects = append(r.objects, legendTitle)
+	for _, plot := range r.widget.Plots {
+		domains := plot.pointDomains()
+		if plot.ShowSizeLegend && plot.SizeScale != SizeFixed {
+			blocks = append(blocks, r.sizeLegendBlock(plot, domains.sizeLo, domains.sizeHi, horizontal))
+		}
+		if plot.ShowColorBar && plot.ColorByValue && domains.colorOK {
+			blocks = append(blocks, r.colorBarBlock(plot.ColorScale, domains.colorLo, domains.colorHi, plot.Title, horizontal))
+		}
+	}
 
-		currentY := y + titleHeight
+	for i, quiver := range quivers {
+		quiverColor := colors[len(r.widget.Plots)+len(r.widget.BoxPlots)+len(r.widget.Waterfalls)+i]
+		if quiver.Color != nil {
+			quiverColor = quiver.Color
+		}
 
-		for i, plot := range r.widget.Plots {
-			plotColor := colors[i]
-			if plot.PlotColor != nil {
-				plotColor = plot.PlotColor
+		if quiver.ShowKey && quiver.Scaling != QuiverFixed && quiver.keyMagnitude() > 0 {
+			blocks = append(blocks, r.quiverKeyBlock(quiver, quiverColor))
+		}
+		if quiver.ShowColorBar && quiver.ColorByMagnitude {
+			if lo, hi, ok := quiver.colorDomain(); ok {
+				blocks = append(blocks, r.colorBarBlock(quiver.ColorScale, lo, hi, quiver.Title, horizontal))
 			}
-
-			r.drawLegendItem(plot, plotColor, x, currentY)
-			currentY += itemHeight
 		}
 	}
+
+	return blocks
 }
 
 // Draw a single legend item
 func (r *scatterChartRenderer) drawLegendItem(plot Plot, plotColor color.Color, x, y float32) {
-	foregroundColor := theme.ForegroundColor()
+	foregroundColor := r.scene.Foreground
 
 	// Draw indicator based on plot style
 	if plot.ShowBars {
 		// Bar chart - draw a small rectangle
-		rect := canvas.NewRectangle(plotColor)
+		rect := r.scene.newRectangle(plotColor)
 		rect.Resize(fyne.NewSize(12, 12))
 		rect.Move(fyne.NewPos(x+10, y+2))
-		r.objects = append(r.objects, rect)
+		r.scene.add(rect)
 	} else if plot.ShowLine && !plot.ShowPoints {
 		// Line only - draw a short line
-		line := canvas.NewLine(plotColor)
-		line.StrokeWidth = plot.LineWidth
-		line.Position1 = fyne.NewPos(x+10, y+5)
-		line.Position2 = fyne.NewPos(x+25, y+5)
-		r.objects = append(r.objects, line)
+		r.drawLegendLine(plot, plotColor, x, y)
 	} else if plot.ShowPoints && !plot.ShowLine {
-		// Points only - draw a circle
-		circle := canvas.NewCircle(plotColor)
-		circle.FillColor = plotColor
-		circle.StrokeColor = plotColor
-		circle.Resize(fyne.NewSize(6, 6))
-		circle.Move(fyne.NewPos(x+14, y+2))
-		r.objects = append(r.objects, circle)
+		// Points only - draw the plot's marker
+		fill, stroke := plot.markerColors(plotColor)
+		r.drawMarker(plot.Marker, x+17, y+5, 3.5, fill, stroke, plot.MarkerStrokeWidth)
 	} else {
-		// Both - draw line with circle
-		line := canvas.NewLine(plotColor)
-		line.StrokeWidth = plot.LineWidth
-		line.Position1 = fyne.NewPos(x+10, y+5)
-		line.Position2 = fyne.NewPos(x+25, y+5)
-		r.objects = append(r.objects, line)
+		// Both - draw line with marker
+		r.drawLegendLine(plot, plotColor, x, y)
 
-		circle := canvas.NewCircle(plotColor)
-		circle.FillColor = plotColor
-		circle.StrokeColor = plotColor
-		circle.Resize(fyne.NewSize(6, 6))
-		circle.Move(fyne.NewPos(x+14, y+2))
-		r.objects = append(r.objects, circle)
+		fill, stroke := plot.markerColors(plotColor)
+		r.drawMarker(plot.Marker, x+17, y+5, 3.5, fill, stroke, plot.MarkerStrokeWidth)
 	}
 
 	// Label
-	label := canvas.NewText(plot.Title, foregroundColor)
+	label := r.scene.newText(plot.Title, foregroundColor)
 	label.TextSize = 10
 	label.Move(fyne.NewPos(x+30, y))
-	r.objects = append(r.objects, label)
+	r.scene.add(label)
+}
+
+// Draw the line sample of a legend item in the plot's line style
+func (r *scatterChartRenderer) drawLegendLine(plot Plot, plotColor color.Color, x, y float32) {
+	points := []fyne.Position{fyne.NewPos(x+10, y+5), fyne.NewPos(x+25, y+5)}
+	r.drawPolyline(points, plotColor, plot.LineWidth, dashPattern(plot.LineStyle, plot.DashPattern, plot.LineWidth))
 }
 
 // Draw border around plot area
 func (r *scatterChartRenderer) drawBorder(width, height, x, y float32) {
-	border := canvas.NewRectangle(color.Transparent)
+	border := r.scene.newRectangle(color.Transparent)
 	border.Resize(fyne.NewSize(width, height))
 	border.Move(fyne.NewPos(x, y))
-	border.StrokeColor = theme.ForegroundColor()
+	border.StrokeColor = r.scene.Foreground
 	border.StrokeWidth = 1.5
-	r.objects = append(r.objects, border)
+	r.scene.add(border)
 }
 
 // Generate colors using a better color palette
-func (r *scatterChartRenderer) generateColors(count int) []color.Color {
+func generateColors(count int) []color.Color {
 	// Professional color palette with good contrast
 	predefinedColors := []color.Color{
 		color.RGBA{R: 31, G: 119, B: 180, A: 255},   // Blue
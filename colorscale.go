@@ -0,0 +1,315 @@
+package fynesimplechart
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+)
+
+const (
+	colorBarLength    float32 = 100
+	colorBarThickness float32 = 12
+)
+
+// Colormap is a continuous color ramp given by evenly spaced color stops
+type Colormap []color.Color
+
+var (
+	// ColormapViridis is a perceptually uniform sequential colormap
+	ColormapViridis = Colormap{
+		color.RGBA{R: 68, G: 1, B: 84, A: 255},
+		color.RGBA{R: 72, G: 40, B: 120, A: 255},
+		color.RGBA{R: 62, G: 74, B: 137, A: 255},
+		color.RGBA{R: 49, G: 104, B: 142, A: 255},
+		color.RGBA{R: 38, G: 130, B: 142, A: 255},
+		color.RGBA{R: 31, G: 158, B: 137, A: 255},
+		color.RGBA{R: 53, G: 183, B: 121, A: 255},
+		color.RGBA{R: 110, G: 206, B: 88, A: 255},
+		color.RGBA{R: 181, G: 222, B: 43, A: 255},
+		color.RGBA{R: 253, G: 231, B: 37, A: 255},
+	}
+
+	// ColormapPlasma is a perceptually uniform sequential colormap
+	ColormapPlasma = Colormap{
+		color.RGBA{R: 13, G: 8, B: 135, A: 255},
+		color.RGBA{R: 84, G: 2, B: 163, A: 255},
+		color.RGBA{R: 139, G: 10, B: 165, A: 255},
+		color.RGBA{R: 185, G: 50, B: 137, A: 255},
+		color.RGBA{R: 219, G: 92, B: 104, A: 255},
+		color.RGBA{R: 244, G: 136, B: 73, A: 255},
+		color.RGBA{R: 254, G: 188, B: 43, A: 255},
+		color.RGBA{R: 240, G: 249, B: 33, A: 255},
+	}
+
+	// ColormapGreys is a sequential colormap that survives greyscale printing
+	ColormapGreys = Colormap{
+		color.RGBA{R: 255, G: 255, B: 255, A: 255},
+		color.RGBA{R: 0, G: 0, B: 0, A: 255},
+	}
+
+	// ColormapRdBu is a diverging colormap from red through white to blue
+	ColormapRdBu = Colormap{
+		color.RGBA{R: 103, G: 0, B: 31, A: 255},
+		color.RGBA{R: 214, G: 96, B: 77, A: 255},
+		color.RGBA{R: 253, G: 219, B: 199, A: 255},
+		color.RGBA{R: 247, G: 247, B: 247, A: 255},
+		color.RGBA{R: 209, G: 229, B: 240, A: 255},
+		color.RGBA{R: 67, G: 147, B: 195, A: 255},
+		color.RGBA{R: 5, G: 48, B: 97, A: 255},
+	}
+
+	// ColormapCoolWarm is a diverging colormap from blue through grey to red
+	ColormapCoolWarm = Colormap{
+		color.RGBA{R: 59, G: 76, B: 192, A: 255},
+		color.RGBA{R: 141, G: 176, B: 254, A: 255},
+		color.RGBA{R: 221, G: 221, B: 221, A: 255},
+		color.RGBA{R: 244, G: 154, B: 123, A: 255},
+		color.RGBA{R: 180, G: 4, B: 38, A: 255},
+	}
+)
+
+// At returns the color at position t, clamped to [0, 1]. NaN gives the
+// first color.
+func (c Colormap) At(t float64) color.Color {
+	if len(c) == 0 {
+		return color.Transparent
+	}
+	if len(c) == 1 || t <= 0 || math.IsNaN(t) {
+		return c[0]
+	}
+	if t >= 1 {
+		return c[len(c)-1]
+	}
+
+	pos := t * float64(len(c)-1)
+	i := int(pos)
+	f := pos - float64(i)
+
+	r1, g1, b1, a1 := c[i].RGBA()
+	r2, g2, b2, a2 := c[i+1].RGBA()
+	mix := func(a, b uint32) uint8 {
+		return uint8((float64(a)*(1-f) + float64(b)*f) / 257)
+	}
+
+	return color.RGBA{R: mix(r1, r2), G: mix(g1, g2), B: mix(b1, b2), A: mix(a1, a2)}
+}
+
+// Normalization defines how values are spread over a colormap
+type Normalization int
+
+const (
+	NormLinear Normalization = iota // Default: values map linearly
+	NormLog                         // Values map by their logarithm; non-positive values are not drawn
+)
+
+// ColorScale maps scalar values to colors
+type ColorScale struct {
+	Colormap      Colormap      // Color ramp (nil uses ColormapViridis)
+	Normalization Normalization // Linear or logarithmic mapping
+	Min           *float32      // Manual low end of the scale (nil = auto)
+	Max           *float32      // Manual high end of the scale (nil = auto)
+	Center        *float32      // Value at the middle of a diverging scale (nil = sequential)
+}
+
+// NewSequentialScale creates a scale running from the lowest to the highest value
+func NewSequentialScale(cmap Colormap) ColorScale {
+	return ColorScale{Colormap: cmap, Normalization: NormLinear}
+}
+
+// NewDivergingScale creates a scale that is symmetric around center
+func NewDivergingScale(cmap Colormap, center float32) ColorScale {
+	return ColorScale{Colormap: cmap, Normalization: NormLinear, Center: &center}
+}
+
+// domain returns the low and high ends of the scale for the given values
+func (s ColorScale) domain(values []float32) (lo, hi float32, ok bool) {
+	for _, v := range values {
+		if !isFinite(v) || (s.Normalization == NormLog && v <= 0) {
+			continue
+		}
+		if !ok {
+			lo, hi, ok = v, v, true
+			continue
+		}
+		lo = float32(math.Min(float64(lo), float64(v)))
+		hi = float32(math.Max(float64(hi), float64(v)))
+	}
+
+	if s.Min != nil {
+		lo = *s.Min
+	}
+	if s.Max != nil {
+		hi = *s.Max
+	}
+	if s.Min != nil && s.Max != nil {
+		ok = true
+	}
+
+	return lo, hi, ok
+}
+
+// normalize returns the position of v on the scale in [0, 1], or false if
+// v cannot be shown.
+func (s ColorScale) normalize(v, lo, hi float32) (float64, bool) {
+	if !isFinite(v) {
+		return 0, false
+	}
+
+	transform := func(x float32) float64 { return float64(x) }
+	if s.Normalization == NormLog {
+		if v <= 0 || lo <= 0 || hi <= 0 {
+			return 0, false
+		}
+		transform = func(x float32) float64 { return math.Log10(float64(x)) }
+	}
+
+	fv, flo, fhi := transform(v), transform(lo), transform(hi)
+
+	var t float64
+	if s.Center != nil && (s.Normalization != NormLog || *s.Center > 0) {
+		fc := transform(*s.Center)
+		span := math.Max(math.Abs(fhi-fc), math.Abs(flo-fc))
+		if span == 0 {
+			return 0.5, true
+		}
+		t = 0.5 + 0.5*(fv-fc)/span
+	} else {
+		if fhi == flo {
+			return 0.5, true
+		}
+		t = (fv - flo) / (fhi - flo)
+	}
+
+	return math.Max(0, math.Min(1, t)), true
+}
+
+// colorAt returns the color of v on a scale running from lo to hi
+func (s ColorScale) colorAt(v, lo, hi float32) color.Color {
+	t, ok := s.normalize(v, lo, hi)
+	if !ok {
+		return color.Transparent
+	}
+
+	cmap := s.Colormap
+	if cmap == nil {
+		cmap = ColormapViridis
+	}
+	return cmap.At(t)
+}
+
+// valueAt is the inverse of normalize, used to label colour bars
+func (s ColorScale) valueAt(t float64, lo, hi float32) float32 {
+	transform := func(x float32) float64 { return float64(x) }
+	inverse := func(x float64) float32 { return float32(x) }
+	if s.Normalization == NormLog {
+		if lo <= 0 || hi <= 0 {
+			return lo
+		}
+		transform = func(x float32) float64 { return math.Log10(float64(x)) }
+		inverse = func(x float64) float32 { return float32(math.Pow(10, x)) }
+	}
+
+	flo, fhi := transform(lo), transform(hi)
+	if s.Center != nil && (s.Normalization != NormLog || *s.Center > 0) {
+		fc := transform(*s.Center)
+		span := math.Max(math.Abs(fhi-fc), math.Abs(flo-fc))
+		return inverse(fc + (2*t-1)*span)
+	}
+	return inverse(flo + t*(fhi-flo))
+}
+
+// isFinite reports whether v is neither NaN nor infinite
+func isFinite(v float32) bool {
+	return !math.IsNaN(float64(v)) && !math.IsInf(float64(v), 0)
+}
+
+// colorBarBlock returns a legend block showing scale from lo to hi
+func (r *scatterChartRenderer) colorBarBlock(scale ColorScale, lo, hi float32, title string, horizontal bool) legendBlock {
+	if horizontal {
+		return legendBlock{
+			width:  colorBarLength + 30,
+			height: 42,
+			draw: func(x, y float32) {
+				r.drawColorBar(scale, lo, hi, title, x, y, true)
+			},
+		}
+	}
+
+	return legendBlock{
+		width:  90,
+		height: colorBarLength + 30,
+		draw: func(x, y float32) {
+			r.drawColorBar(scale, lo, hi, title, x, y, false)
+		},
+	}
+}
+
+// Draw a colour bar with its title and low, middle and high labels
+func (r *scatterChartRenderer) drawColorBar(scale ColorScale, lo, hi float32, title string, x, y float32, horizontal bool) {
+	foregroundColor := r.scene.Foreground
+
+	cmap := scale.Colormap
+	if cmap == nil {
+		cmap = ColormapViridis
+	}
+
+	titleText := r.scene.newText(title, foregroundColor)
+	titleText.TextSize = 10
+	titleText.Move(fyne.NewPos(x+10, y))
+	r.scene.add(titleText)
+
+	barPos := fyne.NewPos(x+10, y+16)
+	barSize := fyne.NewSize(colorBarThickness, colorBarLength)
+	if horizontal {
+		barSize = fyne.NewSize(colorBarLength, colorBarThickness)
+	}
+
+	// Position of pixel i of n along the bar, the middle for a single pixel
+	along := func(i, n int) float64 {
+		if n <= 1 {
+			return 0.5
+		}
+		return float64(i) / float64(n-1)
+	}
+
+	gradient := r.scene.newRaster(func(w, h int) image.Image {
+		img := image.NewRGBA(image.Rect(0, 0, w, h))
+		for py := 0; py < h; py++ {
+			for px := 0; px < w; px++ {
+				if horizontal {
+					img.Set(px, py, cmap.At(along(px, w)))
+				} else {
+					img.Set(px, py, cmap.At(1-along(py, h)))
+				}
+			}
+		}
+		return img
+	})
+	gradient.Move(barPos)
+	gradient.Resize(barSize)
+	r.scene.add(gradient)
+
+	border := r.scene.newRectangle(color.Transparent)
+	border.StrokeColor = foregroundColor
+	border.StrokeWidth = 0.5
+	border.Move(barPos)
+	border.Resize(barSize)
+	r.scene.add(border)
+
+	for _, t := range []float64{0, 0.5, 1} {
+		label := r.scene.newText(formatAxisLabel(scale.valueAt(t, lo, hi)), foregroundColor)
+		label.TextSize = 9
+		labelSize := label.MinSize()
+
+		if horizontal {
+			labelX := barPos.X + float32(t)*colorBarLength - labelSize.Width/2
+			label.Move(fyne.NewPos(labelX, barPos.Y+colorBarThickness+2))
+		} else {
+			labelY := barPos.Y + float32(1-t)*colorBarLength - labelSize.Height/2
+			label.Move(fyne.NewPos(barPos.X+colorBarThickness+4, labelY))
+		}
+		r.scene.add(label)
+	}
+}
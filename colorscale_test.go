@@ -0,0 +1,39 @@
+package fynesimplechart_test
+
+import (
+	"math"
+	"testing"
+
+	"fyne.io/fyne/v2"
+
+	"github.com/alexiusacademia/fynesimplechart"
+)
+
+func TestColormapAtNaN(t *testing.T) {
+	if got, want := fynesimplechart.ColormapViridis.At(math.NaN()), fynesimplechart.ColormapViridis[0]; got != want {
+		t.Errorf("At(NaN) = %v, want the first color %v", got, want)
+	}
+}
+
+// Heatmaps and their colour bars can be drawn one pixel wide or high
+func TestColorBarThinRaster(t *testing.T) {
+	chart := fynesimplechart.NewGraphWidget(nil)
+	chart.Heatmaps = []fynesimplechart.Heatmap{*fynesimplechart.NewHeatmap([][]float32{{0, 1}, {1, 0}}, nil, nil, "heat")}
+
+	rasters := 0
+	for _, primitive := range chart.Scene(fyne.NewSize(400, 300), nil).Primitives {
+		raster, ok := primitive.(*fynesimplechart.SceneRaster)
+		if !ok {
+			continue
+		}
+		rasters++
+		for _, size := range [][2]int{{1, 1}, {1, 40}, {40, 1}} {
+			if img := raster.Generate(size[0], size[1]); img.Bounds().Dx() != size[0] || img.Bounds().Dy() != size[1] {
+				t.Errorf("raster of %v is %v", size, img.Bounds())
+			}
+		}
+	}
+	if rasters < 2 {
+		t.Errorf("%d rasters, want the heatmap and its colour bar", rasters)
+	}
+}
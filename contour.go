@@ -0,0 +1,485 @@
+package fynesimplechart
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"sort"
+
+	"fyne.io/fyne/v2"
+)
+
+type Contour struct {
+	Values [][]float32 // Values[row][col] sampled at (X[col], Y[row])
+	X      []float32   // Grid X positions, ascending
+	Y      []float32   // Grid Y positions, ascending
+	Title  string
+
+	Levels    []float32 // Explicit contour levels, in any order (nil = automatic)
+	NumLevels int       // Approximate number of automatic levels
+
+	Scale     ColorScale  // Maps levels to colors
+	LineWidth float32     // Width of contour lines (0 = no lines)
+	LineColor color.Color // Color for all lines (nil uses Scale, or theme foreground when filled)
+	Filled    bool        // Fill the bands between levels
+
+	ShowLabels   bool        // Show level values along the lines
+	LabelFormat  string      // Format string for labels (e.g., "%.1f")
+	LabelColor   color.Color // Color for labels (nil uses theme foreground)
+	LabelSize    float32     // Font size for labels (0 = default 9)
+	LabelSpacing float32     // Pixels between labels along a line
+
+	ShowColorBar bool // Show a colour bar in the legend area
+}
+
+// NewContour creates a contour series over the given grid positions. Nil
+// positions place samples at unit spacing starting from 0.
+func NewContour(values [][]float32, x, y []float32, title string) *Contour {
+	rows, cols := len(values), 0
+	for _, row := range values {
+		if len(row) > cols {
+			cols = len(row)
+		}
+	}
+
+	if x == nil {
+		x = unitEdges(cols - 1)
+	}
+	if y == nil {
+		y = unitEdges(rows - 1)
+	}
+
+	contour := &Contour{
+		Values:       values,
+		X:            x,
+		Y:            y,
+		Title:        title,
+		Levels:       nil,
+		NumLevels:    10,
+		Scale:        NewSequentialScale(ColormapViridis),
+		LineWidth:    1,
+		LineColor:    nil,
+		Filled:       false,
+		ShowLabels:   true,
+		LabelFormat:  "%.1f",
+		LabelColor:   nil,
+		LabelSize:    9,
+		LabelSpacing: 200,
+		ShowColorBar: true,
+	}
+
+	return contour
+}
+
+// valid reports whether the grid has at least one cell
+func (c Contour) valid() bool {
+	return len(c.X) >= 2 && len(c.Y) >= 2 && len(c.Values) >= 2
+}
+
+// value returns the sample at row, col, or NaN for missing samples
+func (c Contour) value(row, col int) float32 {
+	if row < 0 || row >= len(c.Values) || col < 0 || col >= len(c.Values[row]) {
+		return float32(math.NaN())
+	}
+	return c.Values[row][col]
+}
+
+// colorDomain returns the low and high ends of the contour's colour scale
+func (c Contour) colorDomain() (lo, hi float32, ok bool) {
+	values := []float32{}
+	for _, row := range c.Values {
+		values = append(values, row...)
+	}
+	return c.Scale.domain(values)
+}
+
+// levels returns the contour levels in ascending order. Explicit levels are
+// sorted, without missing values or repeats. Automatic levels fall on nice
+// numbers inside the colour domain.
+func (c Contour) levels() []float32 {
+	if c.Levels != nil {
+		levels := make([]float32, 0, len(c.Levels))
+		for _, level := range c.Levels {
+			if isFinite(level) {
+				levels = append(levels, level)
+			}
+		}
+		sort.Slice(levels, func(i, j int) bool { return levels[i] < levels[j] })
+
+		unique := levels[:0]
+		for _, level := range levels {
+			if len(unique) == 0 || level != unique[len(unique)-1] {
+				unique = append(unique, level)
+			}
+		}
+		return unique
+	}
+
+	lo, hi, ok := c.colorDomain()
+	if !ok || hi <= lo {
+		return nil
+	}
+
+	numLevels := c.NumLevels
+	if numLevels < 1 {
+		numLevels = 10
+	}
+
+	interval := calculateNiceInterval(hi-lo, numLevels)
+	levels := []float32{}
+	// Step by index so rounding errors do not build up (and 0 stays 0)
+	first := math.Ceil(float64(lo / interval))
+	for k := 0; ; k++ {
+		level := float32((first + float64(k)) * float64(interval))
+		if level > hi {
+			break
+		}
+		if level > lo {
+			levels = append(levels, level)
+		}
+	}
+	return levels
+}
+
+// extendRange grows bounds to contain the grid
+func (c Contour) extendRange(bounds *dataRange) {
+	if !c.valid() {
+		return
+	}
+	bounds.include(c.X[0], c.Y[0])
+	bounds.include(c.X[len(c.X)-1], c.Y[len(c.Y)-1])
+}
+
+// sample returns the bilinearly interpolated value at (x, y), or NaN outside
+// the grid or next to a missing sample
+func (c Contour) sample(x, y float32) float32 {
+	col := findCell(c.X, x)
+	row := findCell(c.Y, y)
+	if col < 0 || row < 0 {
+		return float32(math.NaN())
+	}
+
+	tx := (x - c.X[col]) / (c.X[col+1] - c.X[col])
+	ty := (y - c.Y[row]) / (c.Y[row+1] - c.Y[row])
+	v00, v10 := c.value(row, col), c.value(row, col+1)
+	v01, v11 := c.value(row+1, col), c.value(row+1, col+1)
+
+	bottom := v00 + tx*(v10-v00)
+	top := v01 + tx*(v11-v01)
+	return bottom + ty*(top-bottom)
+}
+
+// contourEdge identifies a grid edge: the edge leaving sample (row, col)
+// to the right, or upwards when vertical is set
+type contourEdge struct {
+	row, col int
+	vertical bool
+}
+
+// isolines traces the lines where the field equals level with marching
+// squares and joins the cell segments into polylines in data coordinates
+func (c Contour) isolines(level float32) [][]fyne.Position {
+	// Where the level crosses each grid edge
+	crossing := func(e contourEdge) fyne.Position {
+		row2, col2 := e.row, e.col+1
+		if e.vertical {
+			row2, col2 = e.row+1, e.col
+		}
+		v1, v2 := c.value(e.row, e.col), c.value(row2, col2)
+		t := (level - v1) / (v2 - v1)
+		return fyne.NewPos(c.X[e.col]+t*(c.X[col2]-c.X[e.col]), c.Y[e.row]+t*(c.Y[row2]-c.Y[e.row]))
+	}
+
+	segments := [][2]contourEdge{}
+	for row := 0; row < len(c.Y)-1; row++ {
+		for col := 0; col < len(c.X)-1; col++ {
+			v00, v10 := c.value(row, col), c.value(row, col+1)
+			v01, v11 := c.value(row+1, col), c.value(row+1, col+1)
+			if !isFinite(v00) || !isFinite(v10) || !isFinite(v01) || !isFinite(v11) {
+				continue
+			}
+
+			// Corner bits, counter-clockwise from the bottom left
+			index := 0
+			if v00 > level {
+				index |= 1
+			}
+			if v10 > level {
+				index |= 2
+			}
+			if v11 > level {
+				index |= 4
+			}
+			if v01 > level {
+				index |= 8
+			}
+
+			bottom := contourEdge{row, col, false}
+			right := contourEdge{row, col + 1, true}
+			top := contourEdge{row + 1, col, false}
+			left := contourEdge{row, col, true}
+
+			switch index {
+			case 1, 14:
+				segments = append(segments, [2]contourEdge{left, bottom})
+			case 2, 13:
+				segments = append(segments, [2]contourEdge{bottom, right})
+			case 3, 12:
+				segments = append(segments, [2]contourEdge{left, right})
+			case 4, 11:
+				segments = append(segments, [2]contourEdge{right, top})
+			case 6, 9:
+				segments = append(segments, [2]contourEdge{bottom, top})
+			case 7, 8:
+				segments = append(segments, [2]contourEdge{left, top})
+			case 5, 10:
+				// Saddle: the cell center decides which corners connect
+				centerHigh := (v00+v10+v11+v01)/4 > level
+				if (index == 5) == centerHigh {
+					segments = append(segments, [2]contourEdge{left, top}, [2]contourEdge{bottom, right})
+				} else {
+					segments = append(segments, [2]contourEdge{left, bottom}, [2]contourEdge{right, top})
+				}
+			}
+		}
+	}
+
+	// Join segments that share an edge crossing into polylines
+	byEdge := map[contourEdge][]int{}
+	for i, s := range segments {
+		byEdge[s[0]] = append(byEdge[s[0]], i)
+		byEdge[s[1]] = append(byEdge[s[1]], i)
+	}
+
+	used := make([]bool, len(segments))
+	next := func(e contourEdge) (contourEdge, bool) {
+		for _, i := range byEdge[e] {
+			if used[i] {
+				continue
+			}
+			used[i] = true
+			if segments[i][0] == e {
+				return segments[i][1], true
+			}
+			return segments[i][0], true
+		}
+		return e, false
+	}
+
+	lines := [][]fyne.Position{}
+	for i, s := range segments {
+		if used[i] {
+			continue
+		}
+		used[i] = true
+
+		// Grow the chain in both directions from this segment
+		forward := []contourEdge{s[0], s[1]}
+		for e, ok := next(s[1]); ok; e, ok = next(e) {
+			forward = append(forward, e)
+		}
+		backward := []contourEdge{}
+		for e, ok := next(s[0]); ok; e, ok = next(e) {
+			backward = append(backward, e)
+		}
+
+		line := make([]fyne.Position, 0, len(backward)+len(forward))
+		for k := len(backward) - 1; k >= 0; k-- {
+			line = append(line, crossing(backward[k]))
+		}
+		for _, e := range forward {
+			line = append(line, crossing(e))
+		}
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+// Draw the bands between contour levels as a single raster clipped to the plot area
+func (r *scatterChartRenderer) drawContourFill(c Contour, minX, maxX, minY, maxY, plotWidth, plotHeight, mLeft, mTop float32) {
+	if !c.valid() {
+		return
+	}
+
+	lo, hi, ok := c.colorDomain()
+	levels := c.levels()
+	if !ok || len(levels) == 0 {
+		return
+	}
+
+	// Transform functions from data coordinates to screen coordinates
+	transform := newDataTransform(minX, maxX, minY, maxY, plotWidth, plotHeight, mLeft, mTop)
+	dataToScreenX, dataToScreenY := transform.x, transform.y
+
+	// Screen rectangle covered by the grid, clipped to the plot area
+	left := float32(math.Max(float64(dataToScreenX(c.X[0])), float64(mLeft)))
+	right := float32(math.Min(float64(dataToScreenX(c.X[len(c.X)-1])), float64(mLeft+plotWidth)))
+	top := float32(math.Max(float64(dataToScreenY(c.Y[len(c.Y)-1])), float64(mTop)))
+	bottom := float32(math.Min(float64(dataToScreenY(c.Y[0])), float64(mTop+plotHeight)))
+	if right <= left || bottom <= top {
+		return
+	}
+
+	// Each band takes the color of its middle value; the outer bands run to
+	// the ends of the scale
+	bandColors := make([]color.RGBA, len(levels)+1)
+	for i := range bandColors {
+		bandLo, bandHi := lo, hi
+		if i > 0 {
+			bandLo = levels[i-1]
+		}
+		if i < len(levels) {
+			bandHi = levels[i]
+		}
+		bandColors[i] = color.RGBAModel.Convert(c.Scale.colorAt((bandLo+bandHi)/2, lo, hi)).(color.RGBA)
+	}
+
+	width, height := right-left, bottom-top
+	raster := r.scene.newRaster(func(w, ht int) image.Image {
+		img := image.NewRGBA(image.Rect(0, 0, w, ht))
+
+		for py := 0; py < ht; py++ {
+			screenY := top + (float32(py)+0.5)*height/float32(ht)
+			y := transform.dataY(screenY)
+			for px := 0; px < w; px++ {
+				screenX := left + (float32(px)+0.5)*width/float32(w)
+				v := c.sample(transform.dataX(screenX), y)
+				if !isFinite(v) {
+					continue
+				}
+
+				band := 0
+				for band < len(levels) && v > levels[band] {
+					band++
+				}
+				img.SetRGBA(px, py, bandColors[band])
+			}
+		}
+
+		return img
+	})
+	raster.Pixelated = true
+	raster.Move(fyne.NewPos(left, top))
+	raster.Resize(fyne.NewSize(width, height))
+	r.scene.add(raster)
+}
+
+// Draw the contour lines and their labels
+func (r *scatterChartRenderer) drawContourLines(c Contour, minX, maxX, minY, maxY, plotWidth, plotHeight, mLeft, mTop float32) {
+	if !c.valid() {
+		return
+	}
+
+	lo, hi, ok := c.colorDomain()
+	if !ok {
+		return
+	}
+
+	// Transform function from data coordinates to screen coordinates
+	transform := newDataTransform(minX, maxX, minY, maxY, plotWidth, plotHeight, mLeft, mTop)
+	dataToScreen := func(p fyne.Position) fyne.Position {
+		return transform.position(p.X, p.Y)
+	}
+
+	for _, level := range c.levels() {
+		lineColor := c.LineColor
+		if lineColor == nil {
+			if c.Filled {
+				lineColor = r.scene.Foreground
+			} else {
+				lineColor = c.Scale.colorAt(level, lo, hi)
+			}
+		}
+
+		for _, line := range c.isolines(level) {
+			points := make([]fyne.Position, len(line))
+			for k, p := range line {
+				points[k] = dataToScreen(p)
+			}
+
+			if c.LineWidth > 0 {
+				r.drawPolyline(points, lineColor, c.LineWidth, nil)
+			}
+
+			if c.ShowLabels {
+				r.drawContourLabels(c, level, points, mLeft, mTop, plotWidth, plotHeight)
+			}
+		}
+	}
+}
+
+// Place level labels at regular distances along a contour line, skipping
+// lines too short to carry one
+func (r *scatterChartRenderer) drawContourLabels(c Contour, level float32, points []fyne.Position, mLeft, mTop, plotWidth, plotHeight float32) {
+	labelColor := c.LabelColor
+	if labelColor == nil {
+		labelColor = r.scene.Foreground
+	}
+
+	labelSize := c.LabelSize
+	if labelSize == 0 {
+		labelSize = 9
+	}
+
+	labelFormat := c.LabelFormat
+	if labelFormat == "" {
+		labelFormat = "%.1f"
+	}
+
+	spacing := c.LabelSpacing
+	if spacing <= 0 {
+		spacing = 200
+	}
+
+	text := fmt.Sprintf(labelFormat, level)
+	measure := r.scene.newText(text, labelColor)
+	measure.TextSize = labelSize
+	labelWidth := measure.MinSize().Width
+
+	// The first label sits half a spacing in, or mid-line on short lines
+	total := float32(0)
+	for k := 1; k < len(points); k++ {
+		total += float32(math.Hypot(float64(points[k].X-points[k-1].X), float64(points[k].Y-points[k-1].Y)))
+	}
+	if total < labelWidth*2 {
+		return
+	}
+	nextAt := float32(math.Min(float64(spacing/2), float64(total/2)))
+
+	walked := float32(0)
+	for k := 1; k < len(points); k++ {
+		p1, p2 := points[k-1], points[k]
+		length := float32(math.Hypot(float64(p2.X-p1.X), float64(p2.Y-p1.Y)))
+
+		for length > 0 && nextAt <= walked+length {
+			t := (nextAt - walked) / length
+			pos := fyne.NewPos(p1.X+t*(p2.X-p1.X), p1.Y+t*(p2.Y-p1.Y))
+			nextAt += spacing
+
+			inside := pos.X >= mLeft && pos.X <= mLeft+plotWidth && pos.Y >= mTop && pos.Y <= mTop+plotHeight
+			if !inside {
+				continue
+			}
+
+			label := r.scene.newText(text, labelColor)
+			label.TextSize = labelSize
+			size := label.MinSize()
+
+			// Clear the line behind the label, unless that would punch a
+			// hole in the fill
+			if !c.Filled {
+				background := r.scene.newRectangle(r.scene.Background)
+				background.Resize(fyne.NewSize(size.Width+2, size.Height-2))
+				background.Move(fyne.NewPos(pos.X-size.Width/2-1, pos.Y-size.Height/2+1))
+				r.scene.add(background)
+			}
+
+			label.Move(fyne.NewPos(pos.X-size.Width/2, pos.Y-size.Height/2))
+			r.scene.add(label)
+		}
+
+		walked += length
+	}
+}
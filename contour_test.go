@@ -0,0 +1,97 @@
+package fynesimplechart
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"fyne.io/fyne/v2"
+)
+
+func TestContourIsolines(t *testing.T) {
+	tests := []struct {
+		name   string
+		values [][]float32 // Rows from the bottom up
+		level  float32
+		lines  [][]fyne.Position
+	}{
+		{
+			name:   "line across two cells",
+			values: [][]float32{{0, 0, 0}, {1, 1, 1}},
+			level:  0.5,
+			lines:  [][]fyne.Position{{{X: 0, Y: 0.5}, {X: 1, Y: 0.5}, {X: 2, Y: 0.5}}},
+		},
+		{
+			// High bottom left and top right corners around a low center
+			name:   "saddle with low center",
+			values: [][]float32{{1, 0}, {0, 1}},
+			level:  0.5,
+			lines: [][]fyne.Position{
+				{{X: 0, Y: 0.5}, {X: 0.5, Y: 0}},
+				{{X: 1, Y: 0.5}, {X: 0.5, Y: 1}},
+			},
+		},
+		{
+			// The same corners around a high center join across it
+			name:   "saddle with high center",
+			values: [][]float32{{1, 0}, {0, 1}},
+			level:  0.4,
+			lines: [][]fyne.Position{
+				{{X: 0, Y: 0.6}, {X: 0.4, Y: 1}},
+				{{X: 0.6, Y: 0}, {X: 1, Y: 0.4}},
+			},
+		},
+		{
+			name:   "saddle of the other diagonal",
+			values: [][]float32{{0, 1}, {1, 0}},
+			level:  0.5,
+			lines: [][]fyne.Position{
+				{{X: 0, Y: 0.5}, {X: 0.5, Y: 1}},
+				{{X: 0.5, Y: 0}, {X: 1, Y: 0.5}},
+			},
+		},
+		{
+			name:   "missing sample",
+			values: [][]float32{{1, 0}, {0, float32(math.NaN())}},
+			level:  0.5,
+			lines:  [][]fyne.Position{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines := NewContour(tt.values, nil, nil, "").isolines(tt.level)
+			if len(lines) != len(tt.lines) {
+				t.Fatalf("lines = %v, want %v", lines, tt.lines)
+			}
+			for i, line := range lines {
+				if len(line) != len(tt.lines[i]) {
+					t.Fatalf("lines = %v, want %v", lines, tt.lines)
+				}
+				for k, p := range line {
+					want := tt.lines[i][k]
+					if math.Abs(float64(p.X-want.X)) > 1e-5 || math.Abs(float64(p.Y-want.Y)) > 1e-5 {
+						t.Fatalf("lines = %v, want %v", lines, tt.lines)
+					}
+				}
+			}
+		})
+	}
+}
+
+// Explicit levels come sorted, without missing values or repeats, and the
+// contour's own Levels are left as they were
+func TestContourLevels(t *testing.T) {
+	nan := float32(math.NaN())
+	given := []float32{3, nan, 1, 2, 3, float32(math.Inf(1)), 1}
+	c := Contour{Levels: given}
+
+	got := c.levels()
+	want := []float32{1, 2, 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("levels = %v, want %v", got, want)
+	}
+	if given[0] != 3 || given[2] != 1 {
+		t.Errorf("Levels changed to %v", given)
+	}
+}
@@ -0,0 +1,318 @@
+package fynesimplechart
+
+import (
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+)
+
+// ParametricCurve plots (X(t), Y(t)) for t from TMin to TMax. It is sampled
+// on every render, with more samples where the curve bends on screen.
+type ParametricCurve struct {
+	X    func(t float64) float64
+	Y    func(t float64) float64
+	TMin float64
+	TMax float64
+	Plot Plot // Styling for the curve; Nodes are replaced by the samples
+
+	Tolerance float32 // Allowed deviation from the true curve in pixels
+}
+
+func NewParametricCurve(x, y func(t float64) float64, tMin, tMax float64, title string) *ParametricCurve {
+	plot := NewPlot(nil, title)
+	plot.ShowLine = true
+	plot.ShowPoints = false
+
+	curve := &ParametricCurve{
+		X:         x,
+		Y:         y,
+		TMin:      tMin,
+		TMax:      tMax,
+		Plot:      *plot,
+		Tolerance: 0.5,
+	}
+
+	return curve
+}
+
+// ImplicitCurve plots the points where F(x, y) = 0 inside the visible range.
+// F is evaluated on a grid of Resolution pixel cells, traced with marching
+// squares and every point is then refined onto the curve.
+type ImplicitCurve struct {
+	F    func(x, y float64) float64
+	Plot Plot // Styling for the curve; Nodes are replaced by the samples
+
+	Resolution float32 // Grid cell size in pixels
+}
+
+func NewImplicitCurve(f func(x, y float64) float64, title string) *ImplicitCurve {
+	plot := NewPlot(nil, title)
+	plot.ShowLine = true
+	plot.ShowPoints = false
+
+	curve := &ImplicitCurve{
+		F:          f,
+		Plot:       *plot,
+		Resolution: 4,
+	}
+
+	return curve
+}
+
+// parametricStartSteps is the number of uniform t intervals refined from
+const parametricStartSteps = 64
+
+// point evaluates the curve at t, with non-finite results as a missing node
+func (c ParametricCurve) point(t float64) Node {
+	n := Node{X: float32(c.X(t)), Y: float32(c.Y(t))}
+	if !isFiniteNode(n) {
+		return Node{X: float32(math.NaN()), Y: float32(math.NaN())}
+	}
+	return n
+}
+
+// extendRange grows bounds to contain the curve
+func (c ParametricCurve) extendRange(bounds *dataRange) {
+	if c.X == nil || c.Y == nil || c.TMax <= c.TMin {
+		return
+	}
+
+	const steps = 500
+	for i := 0; i <= steps; i++ {
+		n := c.point(c.TMin + (c.TMax-c.TMin)*float64(i)/steps)
+		if isFiniteNode(n) {
+			bounds.include(n.X, n.Y)
+		}
+	}
+}
+
+// sample evaluates the curve for a view of minX to maxX over plotWidth
+// pixels and minY to maxY over plotHeight pixels. An interval of t is halved
+// while its midpoint strays from the chord by more than Tolerance pixels or
+// the chord is long, up to a fixed depth.
+func (c ParametricCurve) sample(minX, maxX, minY, maxY, plotWidth, plotHeight float32) []Node {
+	if c.X == nil || c.Y == nil || c.TMax <= c.TMin || plotWidth <= 0 || plotHeight <= 0 {
+		return nil
+	}
+
+	pxPerX := float64(plotWidth / (maxX - minX))
+	pxPerY := float64(plotHeight / (maxY - minY))
+	tolerance := float64(c.Tolerance)
+	if tolerance <= 0 {
+		tolerance = 0.5
+	}
+	const maxDepth = 10
+	gap := Node{X: float32(math.NaN()), Y: float32(math.NaN())}
+
+	nodes := []Node{}
+
+	var refine func(t0, t1 float64, n0, n1 Node, depth int)
+	refine = func(t0, t1 float64, n0, n1 Node, depth int) {
+		tm := (t0 + t1) / 2
+		nm := c.point(tm)
+
+		if isFiniteNode(n0) && isFiniteNode(n1) && isFiniteNode(nm) {
+			// Screen-space chord and the midpoint's distance from it
+			ax, ay := float64(n1.X-n0.X)*pxPerX, float64(n1.Y-n0.Y)*pxPerY
+			bx, by := float64(nm.X-n0.X)*pxPerX, float64(nm.Y-n0.Y)*pxPerY
+			chord := math.Hypot(ax, ay)
+			deviation := math.Hypot(bx, by)
+			if chord > 0 {
+				deviation = math.Abs(ax*by-ay*bx) / chord
+			}
+
+			if (deviation <= tolerance && chord <= 32) || depth >= maxDepth {
+				// A long chord that halving does not shorten is a jump
+				half := math.Max(math.Hypot(bx, by), math.Hypot(ax-bx, ay-by))
+				if depth >= maxDepth && chord > float64(plotHeight)/4 && half > 0.75*chord {
+					nodes = append(nodes, gap)
+				}
+				nodes = append(nodes, n1)
+				return
+			}
+		} else if depth >= maxDepth {
+			// Missing values: break the line, keep the finite end
+			nodes = append(nodes, gap)
+			if isFiniteNode(n1) {
+				nodes = append(nodes, n1)
+			}
+			return
+		}
+
+		refine(t0, tm, n0, nm, depth+1)
+		refine(tm, t1, nm, n1, depth+1)
+	}
+
+	t0 := c.TMin
+	n0 := c.point(t0)
+	if isFiniteNode(n0) {
+		nodes = append(nodes, n0)
+	}
+	for i := 1; i <= parametricStartSteps; i++ {
+		t1 := c.TMin + (c.TMax-c.TMin)*float64(i)/parametricStartSteps
+		n1 := c.point(t1)
+		refine(t0, t1, n0, n1, 0)
+		t0, n0 = t1, n1
+	}
+
+	return nodes
+}
+
+// extendRange grows bounds to contain the part of the curve found in the
+// given range
+func (c ImplicitCurve) extendRange(bounds *dataRange, minX, maxX, minY, maxY float32) {
+	// Trace at a fixed coarse resolution; only the extent matters here
+	for _, n := range c.sample(minX, maxX, minY, maxY, 400, 400) {
+		if isFiniteNode(n) {
+			bounds.include(n.X, n.Y)
+		}
+	}
+}
+
+// sample traces the curve for a view of minX to maxX over plotWidth pixels
+// and minY to maxY over plotHeight pixels. Sign changes across a pole are
+// not roots and are dropped.
+func (c ImplicitCurve) sample(minX, maxX, minY, maxY, plotWidth, plotHeight float32) []Node {
+	if c.F == nil || plotWidth <= 0 || plotHeight <= 0 || maxX <= minX || maxY <= minY {
+		return nil
+	}
+
+	resolution := c.Resolution
+	if resolution <= 0 {
+		resolution = 4
+	}
+
+	cols := int(math.Max(2, math.Ceil(float64(plotWidth/resolution))+1))
+	rows := int(math.Max(2, math.Ceil(float64(plotHeight/resolution))+1))
+
+	grid := Contour{X: make([]float32, cols), Y: make([]float32, rows), Values: make([][]float32, rows)}
+	for col := range grid.X {
+		grid.X[col] = minX + (maxX-minX)*float32(col)/float32(cols-1)
+	}
+	for row := range grid.Y {
+		grid.Y[row] = minY + (maxY-minY)*float32(row)/float32(rows-1)
+		grid.Values[row] = make([]float32, cols)
+		for col, x := range grid.X {
+			grid.Values[row][col] = float32(c.F(float64(x), float64(grid.Y[row])))
+		}
+	}
+
+	pxPerX := float64(plotWidth / (maxX - minX))
+	pxPerY := float64(plotHeight / (maxY - minY))
+	cellX := float64(maxX-minX) / float64(cols-1)
+	cellY := float64(maxY-minY) / float64(rows-1)
+	gap := Node{X: float32(math.NaN()), Y: float32(math.NaN())}
+
+	// Newton steps in screen space move a traced point onto the curve
+	refine := func(p fyne.Position) (Node, bool) {
+		x, y := float64(p.X), float64(p.Y)
+		hx, hy := 0.1/pxPerX, 0.1/pxPerY
+		for k := 0; k < 3; k++ {
+			v := c.F(x, y)
+			gx := (c.F(x+hx, y) - c.F(x-hx, y)) / 2 / hx / pxPerX
+			gy := (c.F(x, y+hy) - c.F(x, y-hy)) / 2 / hy / pxPerY
+			g2 := gx*gx + gy*gy
+			if g2 == 0 || math.IsNaN(g2) || math.IsInf(g2, 0) {
+				break
+			}
+			stepX, stepY := -v*gx/g2, -v*gy/g2
+			if math.Hypot(stepX, stepY) > float64(resolution) {
+				break
+			}
+			x += stepX / pxPerX
+			y += stepY / pxPerY
+		}
+
+		// A root is much closer to zero than its surroundings; a pole is not
+		v := math.Abs(c.F(x, y))
+		around := math.Max(
+			math.Max(math.Abs(c.F(x+cellX/2, y)), math.Abs(c.F(x-cellX/2, y))),
+			math.Max(math.Abs(c.F(x, y+cellY/2)), math.Abs(c.F(x, y-cellY/2))),
+		)
+		if math.IsNaN(v) || math.IsInf(v, 0) || v > 0.5*around {
+			return Node{}, false
+		}
+		return Node{X: float32(x), Y: float32(y)}, true
+	}
+
+	nodes := []Node{}
+	for _, line := range grid.isolines(0) {
+		for _, p := range line {
+			if n, ok := refine(p); ok {
+				nodes = append(nodes, n)
+			} else {
+				nodes = append(nodes, gap)
+			}
+		}
+		nodes = append(nodes, gap)
+	}
+
+	return nodes
+}
+
+// curveStyles returns the styling plots of every sampled series, in the
+// order their colours and legend entries are assigned
+func (v *ScatterPlot) curveStyles() []Plot {
+	styles := []Plot{}
+	for _, f := range v.Functions {
+		styles = append(styles, f.Plot)
+	}
+	for _, c := range v.ParametricCurves {
+		styles = append(styles, c.Plot)
+	}
+	for _, c := range v.ImplicitCurves {
+		styles = append(styles, c.Plot)
+	}
+	return styles
+}
+
+// curvePlots samples every function and curve for the visible range and
+// returns them as plots ready for the regular line and fill rendering
+func (r *scatterChartRenderer) curvePlots(minX, maxX, minY, maxY, plotWidth, plotHeight float32) []Plot {
+	plots := r.widget.curveStyles()
+	samples := [][]Node{}
+	for _, f := range r.widget.Functions {
+		samples = append(samples, f.sample(minX, maxX, minY, maxY, plotWidth, plotHeight))
+	}
+	for _, c := range r.widget.ParametricCurves {
+		samples = append(samples, c.sample(minX, maxX, minY, maxY, plotWidth, plotHeight))
+	}
+	for _, c := range r.widget.ImplicitCurves {
+		samples = append(samples, c.sample(minX, maxX, minY, maxY, plotWidth, plotHeight))
+	}
+
+	for i := range plots {
+		plots[i].Nodes = clipNodesY(samples[i], minY, maxY)
+	}
+	return plots
+}
+
+// Fill the inside of each closed run of a curve. Used for curves with
+// FillArea set but neither FillToZero nor FillToPlotIdx.
+func (r *scatterChartRenderer) drawClosedFill(plot Plot, plotColor color.Color, minX, maxX, minY, maxY, plotWidth, plotHeight, mLeft, mTop float32) {
+	// Transform functions from data coordinates to screen coordinates
+	transform := newDataTransform(minX, maxX, minY, maxY, plotWidth, plotHeight, mLeft, mTop)
+	dataToScreenX, dataToScreenY := transform.x, transform.y
+
+	fillColor := plot.FillColor
+	if fillColor == nil {
+		fillColor = translucent(plotColor)
+	}
+
+	paths := [][]fyne.Position{}
+	for _, run := range plot.lineRuns() {
+		if len(run) < 3 {
+			continue
+		}
+		path := make([]fyne.Position, len(run))
+		for k, n := range run {
+			path[k] = fyne.NewPos(dataToScreenX(n.X), dataToScreenY(n.Y))
+		}
+		paths = append(paths, path)
+	}
+
+	if len(paths) > 0 {
+		r.scene.add(newPolygon(paths, fillColor))
+	}
+}
@@ -0,0 +1,37 @@
+package fynesimplechart_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/alexiusacademia/fynesimplechart"
+)
+
+// The range of a chart follows an implicit curve, also after F is replaced
+func TestImplicitCurveRange(t *testing.T) {
+	circle := func(r float64) func(x, y float64) float64 {
+		return func(x, y float64) float64 {
+			return x*x + y*y - r*r
+		}
+	}
+
+	chart := fynesimplechart.NewGraphWidget(nil)
+	chart.ImplicitCurves = []fynesimplechart.ImplicitCurve{*fynesimplechart.NewImplicitCurve(circle(1), "circle")}
+
+	for _, radius := range []float64{1, 2} {
+		chart.ImplicitCurves[0].F = circle(radius)
+		minX, maxX, minY, maxY, err := chart.VisibleRange()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// The extent plus 10% padding on each side
+		want := float32(radius * 1.2)
+		for _, got := range []float32{-minX, maxX, -minY, maxY} {
+			if math.Abs(float64(got-want)) > 0.02 {
+				t.Errorf("radius %v: visible range = %v..%v, %v..%v, want ±%v", radius, minX, maxX, minY, maxY, want)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package fynesimplechart
+
+import (
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+)
+
+// LineStyle defines the dash pattern of a line
+type LineStyle int
+
+const (
+	LineSolid   LineStyle = iota // Default: continuous line
+	LineDashed                   // Long dashes
+	LineDotted                   // Dots
+	LineDashDot                  // Alternating dash and dot
+	LineCustom                   // On/off lengths from DashPattern
+)
+
+// dashPattern returns the on/off lengths in pixels for a line style, scaled
+// with the line width so thick lines keep their proportions. A nil pattern
+// means a solid line.
+func dashPattern(style LineStyle, custom []float32, width float32) []float32 {
+	var base []float32
+	switch style {
+	case LineDashed:
+		base = []float32{5, 3}
+	case LineDotted:
+		base = []float32{1, 2}
+	case LineDashDot:
+		base = []float32{5, 2, 1, 2}
+	case LineCustom:
+		// Custom patterns are given in pixels already
+		pattern := make([]float32, len(custom))
+		visible := false
+		for i, length := range custom {
+			if length > 0 {
+				pattern[i] = length
+				visible = true
+			}
+		}
+		if !visible {
+			return nil
+		}
+		return pattern
+	default:
+		return nil
+	}
+
+	scale := float32(math.Max(float64(width), 1))
+	pattern := make([]float32, len(base))
+	for i, length := range base {
+		pattern[i] = length * scale
+	}
+	return pattern
+}
+
+// dasher splits a polyline into the "on" pieces of a dash pattern. The
+// position in the pattern carries over from one segment to the next so the
+// pattern stays continuous around corners.
+type dasher struct {
+	pattern   []float32
+	index     int
+	remaining float32
+}
+
+func newDasher(pattern []float32) *dasher {
+	// A pattern without a positive length never advances along the line,
+	// so it is drawn solid
+	advances := false
+	for _, length := range pattern {
+		advances = advances || length > 0
+	}
+	if !advances {
+		pattern = nil
+	}
+
+	d := &dasher{pattern: pattern}
+	if len(pattern) > 0 {
+		d.remaining = pattern[0]
+	}
+	return d
+}
+
+// segment emits the visible parts of the segment from p1 to p2
+func (d *dasher) segment(p1, p2 fyne.Position, emit func(a, b fyne.Position)) {
+	if len(d.pattern) == 0 {
+		emit(p1, p2)
+		return
+	}
+
+	dx, dy := p2.X-p1.X, p2.Y-p1.Y
+	length := float32(math.Hypot(float64(dx), float64(dy)))
+	if length == 0 {
+		return
+	}
+
+	pointAt := func(t float32) fyne.Position {
+		return fyne.NewPos(p1.X+dx*t/length, p1.Y+dy*t/length)
+	}
+
+	pos := float32(0)
+	for pos < length {
+		step := float32(math.Min(float64(d.remaining), float64(length-pos)))
+		if d.index%2 == 0 && step > 0 {
+			emit(pointAt(pos), pointAt(pos+step))
+		}
+
+		pos += step
+		d.remaining -= step
+		if d.remaining <= 0 {
+			d.index = (d.index + 1) % len(d.pattern)
+			d.remaining = d.pattern[d.index]
+		}
+	}
+}
+
+// Draw a polyline with a dash pattern that runs continuously along it
+func (r *scatterChartRenderer) drawPolyline(points []fyne.Position, lineColor color.Color, width float32, pattern []float32) {
+	dash := newDasher(pattern)
+	for k := 0; k < len(points)-1; k++ {
+		dash.segment(points[k], points[k+1], func(a, b fyne.Position) {
+			line := r.scene.newLine(lineColor)
+			line.StrokeWidth = width
+			line.Position1 = a
+			line.Position2 = b
+			r.scene.add(line)
+		})
+	}
+}
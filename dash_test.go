@@ -0,0 +1,64 @@
+package fynesimplechart
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+)
+
+// dashPieces returns the on pieces of a dashed polyline
+func dashPieces(pattern []float32, points ...fyne.Position) [][2]fyne.Position {
+	pieces := [][2]fyne.Position{}
+	dash := newDasher(pattern)
+	for k := 0; k < len(points)-1; k++ {
+		dash.segment(points[k], points[k+1], func(a, b fyne.Position) {
+			pieces = append(pieces, [2]fyne.Position{a, b})
+		})
+	}
+	return pieces
+}
+
+// The position in the pattern carries over a corner: a dash cut short by
+// the corner goes on along the next segment
+func TestDasherCorner(t *testing.T) {
+	got := dashPieces([]float32{4, 2}, fyne.NewPos(0, 0), fyne.NewPos(3, 0), fyne.NewPos(3, 5))
+	want := [][2]fyne.Position{
+		{fyne.NewPos(0, 0), fyne.NewPos(3, 0)},
+		{fyne.NewPos(3, 0), fyne.NewPos(3, 1)},
+		{fyne.NewPos(3, 3), fyne.NewPos(3, 5)},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("pieces = %v, want %v", got, want)
+	}
+}
+
+// Patterns without a positive length draw solid lines instead of never
+// getting along the line
+func TestDasherNoLength(t *testing.T) {
+	nan := float32(math.NaN())
+	patterns := [][]float32{{0, 0}, {-3, -1}, {nan, nan}, {0, -2}}
+
+	for _, pattern := range patterns {
+		if styled := dashPattern(LineCustom, pattern, 1); styled != nil {
+			t.Errorf("dashPattern(%v) = %v, want a solid line", pattern, styled)
+		}
+
+		done := make(chan [][2]fyne.Position)
+		go func() {
+			done <- dashPieces(pattern, fyne.NewPos(0, 0), fyne.NewPos(10, 0))
+		}()
+
+		select {
+		case pieces := <-done:
+			want := [][2]fyne.Position{{fyne.NewPos(0, 0), fyne.NewPos(10, 0)}}
+			if !reflect.DeepEqual(pieces, want) {
+				t.Errorf("pattern %v: pieces = %v, want %v", pattern, pieces, want)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("pattern %v: dashing did not finish", pattern)
+		}
+	}
+}
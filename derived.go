@@ -0,0 +1,248 @@
+package fynesimplechart
+
+import (
+	"math"
+	"sort"
+)
+
+// Transform turns the nodes of one unbroken run of a series, in X order,
+// into the nodes of a derived series
+type Transform func(nodes []Node) []Node
+
+// DerivedSeries is computed from a plot in Plots on every render, so it
+// follows changes to the source Nodes. It has its own color and legend entry.
+type DerivedSeries struct {
+	SourceIdx int       // Index of the source plot in Plots
+	Transform Transform // Applied to each run of the source separately
+	Plot      Plot      // Styling for the series; Nodes are replaced by the result
+}
+
+func NewDerivedSeries(sourceIdx int, transform Transform, title string) *DerivedSeries {
+	plot := NewPlot(nil, title)
+	plot.ShowLine = true
+	plot.ShowPoints = false
+
+	series := &DerivedSeries{
+		SourceIdx: sourceIdx,
+		Transform: transform,
+		Plot:      *plot,
+	}
+
+	return series
+}
+
+// nodes applies the transform to every run of the source plot. Gaps in the
+// source stay gaps in the result.
+func (d DerivedSeries) nodes(plots []Plot) []Node {
+	if d.Transform == nil || d.SourceIdx < 0 || d.SourceIdx >= len(plots) {
+		return nil
+	}
+
+	gap := Node{X: float32(math.NaN()), Y: float32(math.NaN())}
+	nodes := []Node{}
+	for _, run := range plots[d.SourceIdx].lineRuns() {
+		if len(nodes) > 0 {
+			nodes = append(nodes, gap)
+		}
+		nodes = append(nodes, d.Transform(run)...)
+	}
+	return nodes
+}
+
+// derivedPlots returns the derived series with their nodes computed
+func (v *ScatterPlot) derivedPlots() []Plot {
+	plots := make([]Plot, len(v.DerivedSeries))
+	for i, d := range v.DerivedSeries {
+		plots[i] = d.Plot
+		plots[i].Nodes = d.nodes(v.Plots)
+	}
+	return plots
+}
+
+// MovingAverage is the simple moving average of the last window nodes. The
+// first window-1 nodes have no average and are left out.
+func MovingAverage(window int) Transform {
+	return func(nodes []Node) []Node {
+		if window < 1 {
+			return nil
+		}
+
+		result := []Node{}
+		sum := float32(0)
+		for i, n := range nodes {
+			sum += n.Y
+			if i >= window {
+				sum -= nodes[i-window].Y
+			}
+			if i >= window-1 {
+				result = append(result, Node{X: n.X, Y: sum / float32(window)})
+			}
+		}
+		return result
+	}
+}
+
+// WeightedMovingAverage is the moving average of the last window nodes with
+// linearly decreasing weights, the newest counting window times the oldest
+func WeightedMovingAverage(window int) Transform {
+	return func(nodes []Node) []Node {
+		if window < 1 {
+			return nil
+		}
+
+		total := float32(window * (window + 1) / 2)
+		result := []Node{}
+		for i := window - 1; i < len(nodes); i++ {
+			sum := float32(0)
+			for k := 0; k < window; k++ {
+				sum += float32(window-k) * nodes[i-k].Y
+			}
+			result = append(result, Node{X: nodes[i].X, Y: sum / total})
+		}
+		return result
+	}
+}
+
+// ExponentialMovingAverage weights each node by alpha and the previous
+// average by 1 - alpha. An alpha of 2/(N+1) behaves like an N node window.
+func ExponentialMovingAverage(alpha float32) Transform {
+	return func(nodes []Node) []Node {
+		if alpha <= 0 || alpha > 1 || len(nodes) == 0 {
+			return nil
+		}
+
+		result := make([]Node, len(nodes))
+		average := nodes[0].Y
+		for i, n := range nodes {
+			average = alpha*n.Y + (1-alpha)*average
+			result[i] = Node{X: n.X, Y: average}
+		}
+		return result
+	}
+}
+
+// SavitzkyGolay fits a polynomial of the given order to the window nodes
+// around each node and takes its value there. Unlike moving averages it
+// keeps peaks in place. Windows near the ends are shifted inwards, and X
+// spacing need not be even.
+func SavitzkyGolay(window, order int) Transform {
+	return func(nodes []Node) []Node {
+		if window < 1 || order < 0 {
+			return nil
+		}
+
+		size := int(math.Min(float64(window), float64(len(nodes))))
+		degree := int(math.Min(float64(order), float64(size-1)))
+
+		result := make([]Node, len(nodes))
+		for i, n := range nodes {
+			result[i] = Node{X: n.X, Y: n.Y}
+			if degree < 1 {
+				continue
+			}
+
+			start := int(math.Max(0, math.Min(float64(i-size/2), float64(len(nodes)-size))))
+			xs := make([]float64, size)
+			ys := make([]float64, size)
+			for k := range xs {
+				xs[k], ys[k] = float64(nodes[start+k].X), float64(nodes[start+k].Y)
+			}
+
+			coefficients, err := fitPolynomial(xs, ys, degree)
+			if err != nil {
+				continue
+			}
+			result[i].Y = float32(Fit{Type: FitPolynomial, Coefficients: coefficients}.Eval(float64(n.X)))
+		}
+
+		return result
+	}
+}
+
+// LOWESS is locally weighted linear regression over the nearest fraction of
+// the nodes, with two robustness passes that discount outliers
+func LOWESS(fraction float32) Transform {
+	return func(nodes []Node) []Node {
+		n := len(nodes)
+		if fraction <= 0 || n == 0 {
+			return nil
+		}
+
+		neighbours := int(math.Max(2, math.Min(float64(n), math.Ceil(float64(fraction)*float64(n)))))
+
+		robustness := make([]float64, n)
+		for j := range robustness {
+			robustness[j] = 1
+		}
+
+		fitted := make([]float64, n)
+		distances := make([]float64, n)
+		sorted := make([]float64, n)
+
+		for pass := 0; pass < 3; pass++ {
+			for i, node := range nodes {
+				x0 := float64(node.X)
+
+				// Bandwidth: distance to the furthest of the nearest neighbours
+				for j, other := range nodes {
+					distances[j] = math.Abs(float64(other.X) - x0)
+				}
+				copy(sorted, distances)
+				sort.Float64s(sorted)
+				bandwidth := sorted[neighbours-1]
+
+				// Tricube-weighted least squares line through the neighbourhood
+				var sw, swx, swy, swxx, swxy float64
+				for j, other := range nodes {
+					w := robustness[j]
+					if bandwidth > 0 {
+						w *= math.Pow(1-math.Pow(math.Min(distances[j]/bandwidth, 1), 3), 3)
+					} else if distances[j] > 0 {
+						w = 0
+					}
+					x, y := float64(other.X), float64(other.Y)
+					sw += w
+					swx += w * x
+					swy += w * y
+					swxx += w * x * x
+					swxy += w * x * y
+				}
+
+				if sw == 0 {
+					fitted[i] = float64(node.Y)
+					continue
+				}
+				meanX, meanY := swx/sw, swy/sw
+				variance := swxx/sw - meanX*meanX
+				fitted[i] = meanY
+				if variance > 1e-12*(1+meanX*meanX) {
+					slope := (swxy/sw - meanX*meanY) / variance
+					fitted[i] = meanY + slope*(x0-meanX)
+				}
+			}
+
+			if pass == 2 {
+				break
+			}
+
+			// Bisquare weights from the residuals for the next pass
+			residuals := make([]float64, n)
+			for j, node := range nodes {
+				residuals[j] = math.Abs(float64(node.Y) - fitted[j])
+			}
+			scale := 6 * median(residuals)
+			if scale == 0 {
+				break
+			}
+			for j, residual := range residuals {
+				robustness[j] = math.Pow(1-math.Pow(math.Min(residual/scale, 1), 2), 2)
+			}
+		}
+
+		result := make([]Node, n)
+		for i, node := range nodes {
+			result[i] = Node{X: node.X, Y: float32(fitted[i])}
+		}
+		return result
+	}
+}
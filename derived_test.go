@@ -0,0 +1,28 @@
+package fynesimplechart_test
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+
+	"github.com/alexiusacademia/fynesimplechart"
+)
+
+// The range and the drawing of a layout share one computation of each
+// derived series
+func TestDerivedComputedOncePerLayout(t *testing.T) {
+	calls := 0
+	count := func(nodes []fynesimplechart.Node) []fynesimplechart.Node {
+		calls++
+		return nodes
+	}
+
+	plot := fynesimplechart.NewPlot([]fynesimplechart.Node{{X: 0, Y: 0}, {X: 1, Y: 2}, {X: 2, Y: 1}}, "source")
+	chart := fynesimplechart.NewGraphWidget([]fynesimplechart.Plot{*plot})
+	chart.DerivedSeries = []fynesimplechart.DerivedSeries{*fynesimplechart.NewDerivedSeries(0, count, "copy")}
+	chart.Scene(fyne.NewSize(400, 300), nil)
+
+	if calls != 1 {
+		t.Errorf("transform ran %d times in one layout, want 1", calls)
+	}
+}
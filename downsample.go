@@ -0,0 +1,188 @@
+package fynesimplechart
+
+import (
+	"math"
+	"sort"
+)
+
+// DownsampleMode defines how a large series is thinned before it is drawn
+type DownsampleMode int
+
+const (
+	DownsampleNone   DownsampleMode = iota // Default: draw every node
+	DownsampleLTTB                         // Largest-Triangle-Three-Buckets, keeps the visual shape
+	DownsampleMinMax                       // First, lowest, highest and last node of each pixel column, keeps every peak
+)
+
+// downsampled returns the plot with only the nodes needed to draw it over
+// the X range from minX to maxX, width pixels wide. Only line and point
+// series with nodes sorted by X are thinned, and their markers are drawn at
+// the nodes kept. Bars and data labels each stand for one observation, so
+// series showing them are never thinned. Series with no more nodes than the threshold are returned
+// as they are. Larger ones keep the nodes of every segment that crosses the
+// visible range, so lines reach the edges, and are thinned if those are
+// still more than the threshold. As it depends on the visible range, zooming in brings back the
+// full detail.
+func (p Plot) downsampled(minX, maxX, width float32) Plot {
+	threshold := p.DownsampleThreshold
+	if threshold <= 0 {
+		threshold = int(2 * width)
+	}
+	if p.Downsample == DownsampleNone || width <= 0 || maxX <= minX || len(p.Nodes) <= threshold {
+		return p
+	}
+	if !(p.ShowLine || p.ShowPoints) || p.ShowBars || p.ShowDataLabels || !sortedByX(p.Nodes) {
+		return p
+	}
+
+	// Visible pieces of every run
+	pieces := [][]Node{}
+	total := 0
+	for _, run := range p.lineRuns() {
+		// A segment is visible if it overlaps the view, even with both of
+		// its nodes outside it, as when zoomed in between two nodes
+		crosses := func(a, b int) bool {
+			if a < 0 || b >= len(run) {
+				return false
+			}
+			return math.Min(float64(run[a].X), float64(run[b].X)) <= float64(maxX) &&
+				math.Max(float64(run[a].X), float64(run[b].X)) >= float64(minX)
+		}
+
+		start := -1
+		for k := 0; k <= len(run); k++ {
+			visible := k < len(run) && (crosses(k, k) || crosses(k-1, k) || crosses(k, k+1))
+			if visible && start < 0 {
+				start = k
+			} else if !visible && start >= 0 {
+				pieces = append(pieces, run[start:k])
+				total += k - start
+				start = -1
+			}
+		}
+	}
+
+	column := func(n Node) int {
+		return int(math.Floor(float64((n.X - minX) / (maxX - minX) * width)))
+	}
+
+	// Pieces are separated by gaps, so the runs of the result are the pieces
+	gap := Node{X: float32(math.NaN()), Y: float32(math.NaN())}
+	nodes := []Node{}
+	for _, piece := range pieces {
+		if total > threshold {
+			switch p.Downsample {
+			case DownsampleMinMax:
+				piece = minMaxNodes(piece, column)
+			default:
+				piece = lttbNodes(piece, int(math.Max(3, float64(threshold)*float64(len(piece))/float64(total))))
+			}
+		}
+
+		if len(nodes) > 0 {
+			nodes = append(nodes, gap)
+		}
+		nodes = append(nodes, piece...)
+	}
+
+	p.Nodes = nodes
+	p.ConnectGaps = 0
+	p.MaxGapX = 0
+	return p
+}
+
+// sortedByX reports whether the finite nodes are in ascending X order
+func sortedByX(nodes []Node) bool {
+	last := float32(math.Inf(-1))
+	for _, n := range nodes {
+		if !isFiniteNode(n) {
+			continue
+		}
+		if n.X < last {
+			return false
+		}
+		last = n.X
+	}
+	return true
+}
+
+// lttbNodes picks count nodes by Largest-Triangle-Three-Buckets: the first
+// and last node, and from each bucket in between the node forming the
+// largest triangle with the node picked before and the next bucket's mean
+func lttbNodes(nodes []Node, count int) []Node {
+	if count < 3 || count >= len(nodes) {
+		return nodes
+	}
+
+	sampled := make([]Node, 0, count)
+	sampled = append(sampled, nodes[0])
+
+	bucketSize := float64(len(nodes)-2) / float64(count-2)
+	picked := nodes[0]
+	for b := 0; b < count-2; b++ {
+		start := int(float64(b)*bucketSize) + 1
+		end := int(float64(b+1)*bucketSize) + 1
+
+		// Mean of the next bucket, or the last node after the final bucket
+		nextEnd := int(math.Min(float64(int(float64(b+2)*bucketSize)+1), float64(len(nodes))))
+		meanX, meanY := 0.0, 0.0
+		for _, n := range nodes[end:nextEnd] {
+			meanX += float64(n.X)
+			meanY += float64(n.Y)
+		}
+		if nextEnd > end {
+			meanX /= float64(nextEnd - end)
+			meanY /= float64(nextEnd - end)
+		}
+
+		best, bestArea := start, -1.0
+		for k := start; k < end; k++ {
+			ax, ay := float64(picked.X), float64(picked.Y)
+			area := math.Abs((ax-meanX)*(float64(nodes[k].Y)-ay) - (ax-float64(nodes[k].X))*(meanY-ay))
+			if area > bestArea {
+				best, bestArea = k, area
+			}
+		}
+
+		picked = nodes[best]
+		sampled = append(sampled, picked)
+	}
+
+	return append(sampled, nodes[len(nodes)-1])
+}
+
+// minMaxNodes keeps the first, lowest, highest and last node of every
+// stretch of nodes falling in the same pixel column, in their original order
+func minMaxNodes(nodes []Node, column func(Node) int) []Node {
+	result := []Node{}
+
+	for start := 0; start < len(nodes); {
+		col := column(nodes[start])
+		end := start + 1
+		for end < len(nodes) && column(nodes[end]) == col {
+			end++
+		}
+
+		lo, hi := start, start
+		for k := start; k < end; k++ {
+			if nodes[k].Y < nodes[lo].Y {
+				lo = k
+			}
+			if nodes[k].Y > nodes[hi].Y {
+				hi = k
+			}
+		}
+
+		picks := []int{start, lo, hi, end - 1}
+		sort.Ints(picks)
+		for i, k := range picks {
+			if i == 0 || k != picks[i-1] {
+				result = append(result, nodes[k])
+			}
+		}
+
+		start = end
+	}
+
+	return result
+}
@@ -0,0 +1,208 @@
+package fynesimplechart
+
+import (
+	"reflect"
+	"testing"
+)
+
+// linePlot returns a line-only plot of count nodes at X = 0, 1, 2... with
+// Y from f
+func linePlot(count int, f func(i int) float32) Plot {
+	nodes := make([]Node, count)
+	for i := range nodes {
+		nodes[i] = Node{X: float32(i), Y: f(i)}
+	}
+	plot := NewPlot(nodes, "")
+	plot.ShowLine = true
+	plot.ShowPoints = false
+	return *plot
+}
+
+func TestLTTBNodes(t *testing.T) {
+	spike := linePlot(100, func(i int) float32 {
+		if i == 50 {
+			return 100
+		}
+		return 0
+	}).Nodes
+	dip := linePlot(100, func(i int) float32 {
+		if i == 17 {
+			return -40
+		}
+		return float32(i % 3)
+	}).Nodes
+
+	tests := []struct {
+		name  string
+		nodes []Node
+		count int
+		keep  []Node // Nodes that must be picked
+	}{
+		{name: "spike", nodes: spike, count: 10, keep: []Node{spike[0], spike[50], spike[99]}},
+		{name: "dip", nodes: dip, count: 20, keep: []Node{dip[0], dip[17], dip[99]}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sampled := lttbNodes(tt.nodes, tt.count)
+			if len(sampled) != tt.count {
+				t.Errorf("%d nodes, want %d", len(sampled), tt.count)
+			}
+			if sampled[0] != tt.nodes[0] || sampled[len(sampled)-1] != tt.nodes[len(tt.nodes)-1] {
+				t.Errorf("ends = %v, %v, want the first and last node", sampled[0], sampled[len(sampled)-1])
+			}
+			for _, n := range tt.keep {
+				found := false
+				for _, s := range sampled {
+					found = found || s == n
+				}
+				if !found {
+					t.Errorf("%v was dropped", n)
+				}
+			}
+			for k := 1; k < len(sampled); k++ {
+				if sampled[k].X <= sampled[k-1].X {
+					t.Fatalf("nodes out of order at %d: %v", k, sampled)
+				}
+			}
+		})
+	}
+
+	// Fewer nodes than asked for are kept as they are
+	if short := lttbNodes(spike[:5], 10); len(short) != 5 {
+		t.Errorf("%d of 5 nodes kept, want all", len(short))
+	}
+}
+
+func TestMinMaxNodes(t *testing.T) {
+	nodes := []Node{
+		// Column 0: first, lowest, highest and last differ
+		{X: 0, Y: 3}, {X: 0.2, Y: 1}, {X: 0.4, Y: 5}, {X: 0.6, Y: 4}, {X: 0.8, Y: 2},
+		// Column 1: flat, so only the first and last
+		{X: 1, Y: 7}, {X: 1.3, Y: 7}, {X: 1.6, Y: 7},
+		// Column 2: a single node
+		{X: 2.5, Y: 0},
+	}
+	column := func(n Node) int { return int(n.X) }
+
+	want := []Node{nodes[0], nodes[1], nodes[2], nodes[4], nodes[5], nodes[7], nodes[8]}
+	if got := minMaxNodes(nodes, column); !reflect.DeepEqual(got, want) {
+		t.Errorf("nodes = %v, want %v", got, want)
+	}
+}
+
+func TestDownsampled(t *testing.T) {
+	wave := func(i int) float32 { return float32(i % 7) }
+
+	tests := []struct {
+		name       string
+		plot       func() Plot
+		minX, maxX float32
+		width      float32
+		count      int // Nodes after downsampling, with gaps
+	}{
+		{
+			name: "off by default",
+			plot: func() Plot { return linePlot(1000, wave) },
+			minX: 0, maxX: 999, width: 100,
+			count: 1000,
+		},
+		{
+			name: "whole series",
+			plot: func() Plot {
+				p := linePlot(1000, wave)
+				p.Downsample = DownsampleLTTB
+				return p
+			},
+			minX: 0, maxX: 999, width: 100,
+			count: 200, // Twice the width
+		},
+		{
+			name: "points shown",
+			plot: func() Plot {
+				p := linePlot(1000, wave)
+				p.Downsample = DownsampleLTTB
+				p.ShowPoints = true
+				return p
+			},
+			minX: 0, maxX: 999, width: 100,
+			count: 200, // Markers are thinned with the line
+		},
+		{
+			name: "points only",
+			plot: func() Plot {
+				p := linePlot(1000, wave)
+				p.Downsample = DownsampleLTTB
+				p.ShowLine = false
+				p.ShowPoints = true
+				return p
+			},
+			minX: 0, maxX: 999, width: 100,
+			count: 200,
+		},
+		{
+			name: "bars shown",
+			plot: func() Plot {
+				p := linePlot(1000, wave)
+				p.Downsample = DownsampleLTTB
+				p.ShowBars = true
+				return p
+			},
+			minX: 0, maxX: 999, width: 100,
+			count: 1000,
+		},
+		{
+			name: "not sorted by X",
+			plot: func() Plot {
+				p := linePlot(1000, wave)
+				p.Downsample = DownsampleLTTB
+				p.Nodes[10], p.Nodes[20] = p.Nodes[20], p.Nodes[10]
+				return p
+			},
+			minX: 0, maxX: 999, width: 100,
+			count: 1000,
+		},
+		{
+			// Zoomed in between two nodes, both are kept to cross the view
+			name: "view between nodes",
+			plot: func() Plot {
+				p := linePlot(1000, wave)
+				p.Downsample = DownsampleLTTB
+				p.DownsampleThreshold = 10
+				return p
+			},
+			minX: 500.2, maxX: 500.8, width: 100,
+			count: 2,
+		},
+		{
+			// The nodes either side of the view and those inside it
+			name: "view inside series",
+			plot: func() Plot {
+				p := linePlot(1000, wave)
+				p.Downsample = DownsampleMinMax
+				p.DownsampleThreshold = 50
+				return p
+			},
+			minX: 100, maxX: 120, width: 100,
+			count: 23,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plot := tt.plot()
+			nodes := plot.downsampled(tt.minX, tt.maxX, tt.width).Nodes
+			if len(nodes) != tt.count {
+				t.Errorf("%d nodes, want %d", len(nodes), tt.count)
+			}
+		})
+	}
+
+	// The kept nodes are the two around the view
+	plot := linePlot(1000, wave)
+	plot.Downsample = DownsampleLTTB
+	nodes := plot.downsampled(500.2, 500.8, 100).Nodes
+	if want := plot.Nodes[500:502]; !reflect.DeepEqual(nodes, want) {
+		t.Errorf("nodes = %v, want %v", nodes, want)
+	}
+}
@@ -0,0 +1,271 @@
+package fynesimplechart
+
+import (
+	"math"
+	"sort"
+)
+
+// Function plots y = F(x). It is sampled on every render across the visible
+// X range, with more samples where the curve bends, and the line breaks at
+// discontinuities and wherever F returns NaN or ±Inf.
+type Function struct {
+	F    func(x float64) float64
+	Plot Plot // Styling for the curve; Nodes are replaced by the samples
+
+	XMin *float32 // Lower end of the domain (nil = visible range)
+	XMax *float32 // Upper end of the domain (nil = visible range)
+
+	Tolerance float32 // Allowed deviation from the true curve in pixels
+}
+
+func NewFunction(f func(x float64) float64, title string) *Function {
+	plot := NewPlot(nil, title)
+	plot.ShowLine = true
+	plot.ShowPoints = false
+
+	function := &Function{
+		F:         f,
+		Plot:      *plot,
+		XMin:      nil,
+		XMax:      nil,
+		Tolerance: 0.5,
+	}
+
+	return function
+}
+
+// defaultFunctionRange is the X range shown when nothing else sets one
+const defaultFunctionRange float32 = 10
+
+// domain clips the range from lo to hi to the function's domain
+func (f Function) domain(lo, hi float32) (float32, float32) {
+	if f.XMin != nil {
+		lo = float32(math.Max(float64(lo), float64(*f.XMin)))
+	}
+	if f.XMax != nil {
+		hi = float32(math.Min(float64(hi), float64(*f.XMax)))
+	}
+	return lo, hi
+}
+
+// eval returns F(x), with infinities reported as missing
+func (f Function) eval(x float64) float32 {
+	y := float32(f.F(x))
+	if !isFinite(y) {
+		return float32(math.NaN())
+	}
+	return y
+}
+
+// extendRange grows bounds by the values of the function over the X range
+// from lo to hi. A uniform scan is enough here; samples next to a jump are
+// left out so a pole such as tan's does not swamp the range.
+func (f Function) extendRange(bounds *dataRange, lo, hi float32) {
+	lo, hi = f.domain(lo, hi)
+	if f.F == nil || hi <= lo {
+		return
+	}
+
+	const steps = 200
+	ys := make([]float32, steps+1)
+	for i := range ys {
+		ys[i] = f.eval(float64(lo + (hi-lo)*float32(i)/steps))
+	}
+
+	// Typical step size, to recognise jumps
+	diffs := []float64{}
+	for i := 1; i < len(ys); i++ {
+		if isFinite(ys[i]) && isFinite(ys[i-1]) {
+			diffs = append(diffs, math.Abs(float64(ys[i]-ys[i-1])))
+		}
+	}
+	typical := median(diffs)
+
+	isJump := func(i, j int) bool {
+		if j < 0 || j >= len(ys) || !isFinite(ys[j]) {
+			return false
+		}
+		return math.Abs(float64(ys[i]-ys[j])) > 20*typical+1e-9
+	}
+
+	for i, y := range ys {
+		if !isFinite(y) || isJump(i, i-1) || isJump(i, i+1) {
+			continue
+		}
+		bounds.include(lo+(hi-lo)*float32(i)/steps, y)
+	}
+}
+
+// median returns the middle of values, or 0 for none
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]float64{}, values...)
+	sort.Float64s(sorted)
+	return sorted[len(sorted)/2]
+}
+
+// sample evaluates the function across the visible range from minX to maxX,
+// drawn plotWidth pixels wide with minY to maxY over plotHeight pixels. An
+// interval is halved while its midpoint strays from the chord by more than
+// Tolerance pixels, down to half a pixel. A narrow interval whose jump does
+// not shrink when halved is a discontinuity and gets a missing node.
+func (f Function) sample(minX, maxX, minY, maxY, plotWidth, plotHeight float32) []Node {
+	lo, hi := f.domain(minX, maxX)
+	if f.F == nil || hi <= lo || plotWidth <= 0 || plotHeight <= 0 {
+		return nil
+	}
+
+	pxPerX := float64(plotWidth / (maxX - minX))
+	pxPerY := float64(plotHeight / (maxY - minY))
+	tolerance := float64(f.Tolerance)
+	if tolerance <= 0 {
+		tolerance = 0.5
+	}
+	minWidth := 0.5 / pxPerX
+	gap := Node{X: float32(math.NaN()), Y: float32(math.NaN())}
+
+	nodes := []Node{}
+	emit := func(x float64, y float32) {
+		nodes = append(nodes, Node{X: float32(x), Y: y})
+	}
+
+	var refine func(x0, x1 float64, y0, y1 float32)
+	refine = func(x0, x1 float64, y0, y1 float32) {
+		xm := (x0 + x1) / 2
+		ym := f.eval(xm)
+
+		finite := isFinite(y0) && isFinite(y1) && isFinite(ym)
+		narrow := x1-x0 <= minWidth
+
+		if finite {
+			deviation := math.Abs(float64(ym-(y0+y1)/2)) * pxPerY
+			if deviation <= tolerance {
+				emit(x1, y1)
+				return
+			}
+			if narrow {
+				// A jump that halving does not shrink is a discontinuity
+				jump := math.Abs(float64(y1-y0)) * pxPerY
+				halfJump := math.Max(math.Abs(float64(ym-y0)), math.Abs(float64(y1-ym))) * pxPerY
+				if jump > float64(plotHeight)/4 && halfJump > 0.75*jump {
+					nodes = append(nodes, gap)
+				}
+				emit(x1, y1)
+				return
+			}
+		} else if narrow {
+			// Missing values: break the line, keep the finite end
+			nodes = append(nodes, gap)
+			if isFinite(y1) {
+				emit(x1, y1)
+			}
+			return
+		}
+
+		refine(x0, xm, y0, ym)
+		refine(xm, x1, ym, y1)
+	}
+
+	// Start from a uniform grid of about one sample per 8 pixels
+	steps := int(math.Max(16, math.Ceil(float64(hi-lo)*pxPerX/8)))
+	x0 := float64(lo)
+	y0 := f.eval(x0)
+	if isFinite(y0) {
+		emit(x0, y0)
+	}
+	for i := 1; i <= steps; i++ {
+		x1 := float64(lo) + float64(hi-lo)*float64(i)/float64(steps)
+		y1 := f.eval(x1)
+		refine(x0, x1, y0, y1)
+		x0, y0 = x1, y1
+	}
+
+	return nodes
+}
+
+// scanRange returns the range functions and implicit curves are scanned
+// over for auto-ranging: the manual range, else the extent of the other
+// series, else the functions' own domains, else ±10
+func (v *ScatterPlot) scanRange(bounds dataRange) (minX, maxX, minY, maxY float32) {
+	minX, maxX = -defaultFunctionRange, defaultFunctionRange
+	minY, maxY = -defaultFunctionRange, defaultFunctionRange
+	if bounds.ok {
+		minX, maxX = bounds.minX, bounds.maxX
+		minY, maxY = bounds.minY, bounds.maxY
+	} else {
+		for _, f := range v.Functions {
+			if f.XMin != nil {
+				minX = *f.XMin
+			}
+			if f.XMax != nil {
+				maxX = *f.XMax
+			}
+		}
+	}
+
+	if v.MinX != nil {
+		minX = *v.MinX
+	}
+	if v.MaxX != nil {
+		maxX = *v.MaxX
+	}
+	if v.MinY != nil {
+		minY = *v.MinY
+	}
+	if v.MaxY != nil {
+		maxY = *v.MaxY
+	}
+	return minX, maxX, minY, maxY
+}
+
+// clipNodesY cuts a line to the Y range from lo to hi. Parts outside are
+// replaced by missing nodes, with new end points where the line crosses
+// the edge, so steep curves do not spill out of the plot area.
+func clipNodesY(nodes []Node, lo, hi float32) []Node {
+	gap := Node{X: float32(math.NaN()), Y: float32(math.NaN())}
+	clipped := []Node{}
+	open := false // The last clipped node continues into the next segment
+
+	for k := 1; k < len(nodes); k++ {
+		a, b := nodes[k-1], nodes[k]
+		if !isFiniteNode(a) || !isFiniteNode(b) {
+			open = false
+			continue
+		}
+
+		// Part of the segment inside the range, as fractions of its length
+		t0, t1 := float32(0), float32(1)
+		dy := b.Y - a.Y
+		if dy == 0 {
+			if a.Y < lo || a.Y > hi {
+				open = false
+				continue
+			}
+		} else {
+			tLo, tHi := (lo-a.Y)/dy, (hi-a.Y)/dy
+			t0 = float32(math.Max(0, math.Min(float64(tLo), float64(tHi))))
+			t1 = float32(math.Min(1, math.Max(float64(tLo), float64(tHi))))
+			if t0 > t1 {
+				open = false
+				continue
+			}
+		}
+
+		at := func(t float32) Node {
+			return Node{X: a.X + t*(b.X-a.X), Y: a.Y + t*dy}
+		}
+
+		if !open {
+			if len(clipped) > 0 {
+				clipped = append(clipped, gap)
+			}
+			clipped = append(clipped, at(t0))
+		}
+		clipped = append(clipped, at(t1))
+		open = t1 >= 1
+	}
+
+	return clipped
+}
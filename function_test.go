@@ -0,0 +1,84 @@
+package fynesimplechart
+
+import (
+	"math"
+	"testing"
+)
+
+// Samples of functions with poles break there, with no line across the pole
+func TestFunctionSamplePoles(t *testing.T) {
+	tests := []struct {
+		name  string
+		f     func(x float64) float64
+		lo    float32
+		hi    float32
+		poles []float64
+	}{
+		{name: "tan", f: math.Tan, lo: -3, hi: 3, poles: []float64{-math.Pi / 2, math.Pi / 2}},
+		{name: "1/x", f: func(x float64) float64 { return 1 / x }, lo: -5, hi: 5, poles: []float64{0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewFunction(tt.f, tt.name)
+			nodes := f.sample(tt.lo, tt.hi, -10, 10, 400, 300)
+
+			for _, pole := range tt.poles {
+				// Nodes between the last finite one left of the pole and the
+				// first finite one right of it
+				before, after := -1, -1
+				for i, n := range nodes {
+					if !isFiniteNode(n) {
+						continue
+					}
+					if float64(n.X) < pole {
+						before = i
+					} else if float64(n.X) > pole && after < 0 {
+						after = i
+					}
+				}
+				if before < 0 || after < 0 {
+					t.Fatalf("no samples on both sides of the pole at %v", pole)
+				}
+				breaks := false
+				for _, n := range nodes[before+1 : after] {
+					breaks = breaks || !isFiniteNode(n)
+				}
+				if !breaks {
+					t.Errorf("line from %v to %v crosses the pole at %v", nodes[before], nodes[after], pole)
+				}
+			}
+		})
+	}
+}
+
+// Between two samples of a smooth function the curve stays within
+// Tolerance pixels of the line drawn
+func TestFunctionSampleTolerance(t *testing.T) {
+	const width, height = 400, 300
+	minX, maxX, minY, maxY := float32(0), float32(2*math.Pi), float32(-1.2), float32(1.2)
+	pxPerX := float64(width / (maxX - minX))
+	pxPerY := float64(height / (maxY - minY))
+
+	for _, tolerance := range []float32{0.5, 0.1} {
+		f := NewFunction(math.Sin, "sin")
+		f.Tolerance = tolerance
+		nodes := f.sample(minX, maxX, minY, maxY, width, height)
+
+		for i := 1; i < len(nodes); i++ {
+			a, b := nodes[i-1], nodes[i]
+			if !isFiniteNode(a) || !isFiniteNode(b) {
+				t.Fatalf("tolerance %v: missing node in a smooth function at %d", tolerance, i)
+			}
+			if dx := float64(b.X-a.X) * pxPerX; dx > 8.5 {
+				t.Errorf("tolerance %v: samples %v and %v are %v pixels apart", tolerance, a, b, dx)
+			}
+
+			xm := float64(a.X+b.X) / 2
+			deviation := math.Abs(math.Sin(xm)-float64(a.Y+b.Y)/2) * pxPerY
+			if deviation > float64(tolerance)+0.01 {
+				t.Errorf("tolerance %v: curve strays %v pixels from the line between %v and %v", tolerance, deviation, a, b)
+			}
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package fynesimplechart
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"sort"
+
+	"fyne.io/fyne/v2"
+)
+
+type Heatmap struct {
+	Values [][]float32 // Values[row][col]; rows run along Y, columns along X
+	XEdges []float32   // Column boundaries, len(columns)+1 ascending values
+	YEdges []float32   // Row boundaries, len(rows)+1 ascending values
+	Title  string
+
+	Scale        ColorScale // Maps values to colors
+	ShowColorBar bool       // Show a colour bar in the legend area
+}
+
+// NewHeatmap creates a heatmap over the given cell edges. Nil edges place
+// cells at unit spacing starting from 0.
+func NewHeatmap(values [][]float32, xEdges, yEdges []float32, title string) *Heatmap {
+	rows, cols := len(values), 0
+	for _, row := range values {
+		if len(row) > cols {
+			cols = len(row)
+		}
+	}
+
+	if xEdges == nil {
+		xEdges = unitEdges(cols)
+	}
+	if yEdges == nil {
+		yEdges = unitEdges(rows)
+	}
+
+	heatmap := &Heatmap{
+		Values:       values,
+		XEdges:       xEdges,
+		YEdges:       yEdges,
+		Title:        title,
+		Scale:        NewSequentialScale(ColormapViridis),
+		ShowColorBar: true,
+	}
+
+	return heatmap
+}
+
+// unitEdges returns n+1 edges at 0, 1, ..., n
+func unitEdges(n int) []float32 {
+	edges := make([]float32, n+1)
+	for i := range edges {
+		edges[i] = float32(i)
+	}
+	return edges
+}
+
+// valid reports whether the edges describe at least one cell
+func (h Heatmap) valid() bool {
+	return len(h.XEdges) >= 2 && len(h.YEdges) >= 2 && len(h.Values) > 0
+}
+
+// value returns the cell value at row, col, or NaN for missing cells
+func (h Heatmap) value(row, col int) float32 {
+	if row < 0 || row >= len(h.Values) || col < 0 || col >= len(h.Values[row]) {
+		return float32(math.NaN())
+	}
+	return h.Values[row][col]
+}
+
+// colorDomain returns the low and high ends of the heatmap's colour scale
+func (h Heatmap) colorDomain() (lo, hi float32, ok bool) {
+	values := []float32{}
+	for _, row := range h.Values {
+		values = append(values, row...)
+	}
+	return h.Scale.domain(values)
+}
+
+// extendRange grows bounds to contain every cell
+func (h Heatmap) extendRange(bounds *dataRange) {
+	if !h.valid() {
+		return
+	}
+	bounds.include(h.XEdges[0], h.YEdges[0])
+	bounds.include(h.XEdges[len(h.XEdges)-1], h.YEdges[len(h.YEdges)-1])
+}
+
+// findCell returns the index of the cell between edges that contains v, or -1
+func findCell(edges []float32, v float32) int {
+	i := sort.Search(len(edges), func(i int) bool { return edges[i] > v }) - 1
+	if i < 0 || i >= len(edges)-1 {
+		return -1
+	}
+	return i
+}
+
+// Draw a heatmap as a single raster clipped to the plot area
+func (r *scatterChartRenderer) drawHeatmap(h Heatmap, minX, maxX, minY, maxY, plotWidth, plotHeight, mLeft, mTop float32) {
+	if !h.valid() {
+		return
+	}
+
+	lo, hi, ok := h.colorDomain()
+	if !ok {
+		return
+	}
+
+	// Transform functions from data coordinates to screen coordinates
+	transform := newDataTransform(minX, maxX, minY, maxY, plotWidth, plotHeight, mLeft, mTop)
+	dataToScreenX, dataToScreenY := transform.x, transform.y
+
+	// Screen rectangle covered by the cells, clipped to the plot area
+	left := float32(math.Max(float64(dataToScreenX(h.XEdges[0])), float64(mLeft)))
+	right := float32(math.Min(float64(dataToScreenX(h.XEdges[len(h.XEdges)-1])), float64(mLeft+plotWidth)))
+	top := float32(math.Max(float64(dataToScreenY(h.YEdges[len(h.YEdges)-1])), float64(mTop)))
+	bottom := float32(math.Min(float64(dataToScreenY(h.YEdges[0])), float64(mTop+plotHeight)))
+	if right <= left || bottom <= top {
+		return
+	}
+
+	// Resolve each cell's color once; pixels only look them up
+	rows, cols := len(h.YEdges)-1, len(h.XEdges)-1
+	cellColors := make([]color.RGBA, rows*cols)
+	for row := 0; row < rows; row++ {
+		for col := 0; col < cols; col++ {
+			c := color.RGBAModel.Convert(h.Scale.colorAt(h.value(row, col), lo, hi)).(color.RGBA)
+			cellColors[row*cols+col] = c
+		}
+	}
+
+	width, height := right-left, bottom-top
+	raster := r.scene.newRaster(func(w, ht int) image.Image {
+		img := image.NewRGBA(image.Rect(0, 0, w, ht))
+		if w == 0 || ht == 0 {
+			return img
+		}
+
+		colIdx := make([]int, w)
+		for px := 0; px < w; px++ {
+			screenX := left + (float32(px)+0.5)*width/float32(w)
+			colIdx[px] = findCell(h.XEdges, transform.dataX(screenX))
+		}
+
+		for py := 0; py < ht; py++ {
+			screenY := top + (float32(py)+0.5)*height/float32(ht)
+			row := findCell(h.YEdges, transform.dataY(screenY))
+			if row < 0 {
+				continue
+			}
+			for px, col := range colIdx {
+				if col >= 0 {
+					img.SetRGBA(px, py, cellColors[row*cols+col])
+				}
+			}
+		}
+
+		return img
+	})
+	raster.Pixelated = true
+	raster.Move(fyne.NewPos(left, top))
+	raster.Resize(fyne.NewSize(width, height))
+	r.scene.add(raster)
+}
@@ -0,0 +1,124 @@
+package fynesimplechart
+
+import (
+	"fyne.io/fyne/v2"
+)
+
+// legendBlock is a legend element larger than a single row, such as a colour bar
+type legendBlock struct {
+	width, height float32
+	draw          func(x, y float32)
+}
+
+// drawLegendLayout places legend rows and blocks for a chart widget. Every
+// widget in the package lays its legend out here so they look alike.
+func drawLegendLayout(position LegendPosition, hasChartTitle bool, entries []func(x, y float32), blocks []legendBlock, widgetWidth, widgetHeight, mTop, mRight, mBottom float32, scene *Scene) {
+	if len(entries) == 0 && len(blocks) == 0 {
+		return
+	}
+
+	previousLayer := scene.layer
+	scene.setLayer(layerLegend)
+	defer scene.setLayer(previousLayer)
+
+	foregroundColor := scene.Foreground
+
+	// Calculate legend dimensions
+	itemHeight := float32(20)
+	titleHeight := float32(18)
+	numItems := len(entries)
+
+	// Determine position based on LegendPosition
+	var x, y float32
+
+	switch position {
+	case LegendRight:
+		x = widgetWidth - mRight
+		y = mTop
+
+		legendTitle := scene.newText("LEGEND", foregroundColor)
+		legendTitle.TextSize = 11
+		legendTitle.TextStyle.Bold = true
+		legendTitle.Move(fyne.NewPos(x+5, y))
+		scene.add(legendTitle)
+
+		currentY := y + titleHeight
+
+		for _, drawEntry := range entries {
+			drawEntry(x, currentY)
+			currentY += itemHeight
+		}
+
+		for _, block := range blocks {
+			block.draw(x, currentY)
+			currentY += block.height
+		}
+
+	case LegendBottom:
+		// Center legend at bottom
+		itemWidth := float32(120)
+		legendWidth := float32(numItems) * itemWidth
+		for _, block := range blocks {
+			legendWidth += block.width
+		}
+		x = (widgetWidth - legendWidth) / 2
+		y = widgetHeight - mBottom + 20
+
+		for i, drawEntry := range entries {
+			itemX := x + float32(i)*itemWidth
+			drawEntry(itemX, y)
+		}
+
+		blockX := x + float32(numItems)*itemWidth
+		for _, block := range blocks {
+			block.draw(blockX, y)
+			blockX += block.width
+		}
+
+	case LegendTop:
+		// Center legend at top (below title if present)
+		itemWidth := float32(120)
+		legendWidth := float32(numItems) * itemWidth
+		for _, block := range blocks {
+			legendWidth += block.width
+		}
+		x = (widgetWidth - legendWidth) / 2
+		y = float32(30)
+		if hasChartTitle {
+			y = 35
+		}
+
+		for i, drawEntry := range entries {
+			itemX := x + float32(i)*itemWidth
+			drawEntry(itemX, y)
+		}
+
+		blockX := x + float32(numItems)*itemWidth
+		for _, block := range blocks {
+			block.draw(blockX, y)
+			blockX += block.width
+		}
+
+	case LegendLeft:
+		x = float32(10)
+		y = mTop
+
+		legendTitle := scene.newText("LEGEND", foregroundColor)
+		legendTitle.TextSize = 11
+		legendTitle.TextStyle.Bold = true
+		legendTitle.Move(fyne.NewPos(x+5, y))
+		scene.add(legendTitle)
+
+		currentY := y + titleHeight
+
+		for _, drawEntry := range entries {
+			drawEntry(x, currentY)
+			currentY += itemHeight
+		}
+
+		for _, block := range blocks {
+			block.draw(x, currentY)
+			currentY += block.height
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package fynesimplechart
+
+import (
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+)
+
+// MarkerShape defines the symbol drawn at each point
+type MarkerShape int
+
+const (
+	MarkerCircle       MarkerShape = iota // Default
+	MarkerSquare                          // Axis-aligned square
+	MarkerDiamond                         // Square rotated by 45 degrees
+	MarkerTriangleUp                      // Triangle pointing up
+	MarkerTriangleDown                    // Triangle pointing down
+	MarkerCross                           // Diagonal cross (x), stroke only
+	MarkerPlus                            // Upright cross (+), stroke only
+	MarkerStar                            // Five-pointed star
+)
+
+// markerOutline returns the vertices of a polygonal marker of unit radius,
+// or nil for markers that are not drawn as polygons
+func markerOutline(shape MarkerShape) []fyne.Position {
+	switch shape {
+	case MarkerDiamond:
+		return []fyne.Position{{X: 0, Y: -1}, {X: 1, Y: 0}, {X: 0, Y: 1}, {X: -1, Y: 0}}
+	case MarkerTriangleUp:
+		return []fyne.Position{{X: 0, Y: -1}, {X: 0.866, Y: 0.5}, {X: -0.866, Y: 0.5}}
+	case MarkerTriangleDown:
+		return []fyne.Position{{X: 0, Y: 1}, {X: -0.866, Y: -0.5}, {X: 0.866, Y: -0.5}}
+	case MarkerStar:
+		points := make([]fyne.Position, 10)
+		for i := range points {
+			radius := 1.0
+			if i%2 == 1 {
+				radius = 0.4
+			}
+			angle := float64(i) * math.Pi / 5
+			points[i] = fyne.NewPos(float32(radius*math.Sin(angle)), float32(-radius*math.Cos(angle)))
+		}
+		return points
+	}
+	return nil
+}
+
+// markerColors returns the fill and stroke colors of a plot's markers.
+// Explicit marker colors win over base, which is the series or value color.
+func (p Plot) markerColors(base color.Color) (fill, stroke color.Color) {
+	fill, stroke = base, base
+	if p.MarkerFillColor != nil {
+		fill = p.MarkerFillColor
+	}
+	if p.MarkerStrokeColor != nil {
+		stroke = p.MarkerStrokeColor
+	}
+	if p.MarkerHollow {
+		fill = color.Transparent
+	}
+	return fill, stroke
+}
+
+// Draw a single marker centered on (x, y)
+func (r *scatterChartRenderer) drawMarker(shape MarkerShape, x, y, radius float32, fill, stroke color.Color, strokeWidth float32) {
+	addLine := func(x1, y1, x2, y2 float32) {
+		line := r.scene.newLine(stroke)
+		line.StrokeWidth = float32(math.Max(float64(strokeWidth), 1))
+		line.Position1 = fyne.NewPos(x1, y1)
+		line.Position2 = fyne.NewPos(x2, y2)
+		r.scene.add(line)
+	}
+
+	switch shape {
+	case MarkerSquare:
+		rect := r.scene.newRectangle(fill)
+		rect.StrokeColor = stroke
+		rect.StrokeWidth = strokeWidth
+		rect.Resize(fyne.NewSize(radius*2, radius*2))
+		rect.Move(fyne.NewPos(x-radius, y-radius))
+		r.scene.add(rect)
+
+	case MarkerCross:
+		// Keep the arms the same length as the circle's radius
+		arm := radius * 0.707
+		addLine(x-arm, y-arm, x+arm, y+arm)
+		addLine(x-arm, y+arm, x+arm, y-arm)
+
+	case MarkerPlus:
+		addLine(x-radius, y, x+radius, y)
+		addLine(x, y-radius, x, y+radius)
+
+	case MarkerDiamond, MarkerTriangleUp, MarkerTriangleDown, MarkerStar:
+		outline := markerOutline(shape)
+		points := make([]fyne.Position, len(outline))
+		for i, p := range outline {
+			points[i] = fyne.NewPos(x+p.X*radius, y+p.Y*radius)
+		}
+
+		if _, _, _, a := fill.RGBA(); a > 0 {
+			r.scene.add(newPolygon([][]fyne.Position{points}, fill))
+		}
+		if strokeWidth > 0 {
+			for i := range points {
+				next := points[(i+1)%len(points)]
+				addLine(points[i].X, points[i].Y, next.X, next.Y)
+			}
+		}
+
+	default:
+		circle := r.scene.newCircle(fill)
+		circle.FillColor = fill
+		circle.StrokeColor = stroke
+		circle.StrokeWidth = strokeWidth
+		circle.Resize(fyne.NewSize(radius*2, radius*2))
+		circle.Move(fyne.NewPos(x-radius, y-radius))
+		r.scene.add(circle)
+	}
+}
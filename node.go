@@ -5,17 +5,50 @@ import "errors"
 type Node struct {
 	X float32
 	Y float32
+
+	// Optional channels for bubble charts
+	Size  float32 // Mapped to marker size when Plot.SizeScale is set
+	Value float32 // Mapped to marker color when Plot.ColorByValue is set
+
+	// Optional vector for quiver series
+	DX float32
+	DY float32
 }
 
 func NewNode(x float32, y float32) *Node {
 	return &Node{X: x, Y: y}
 }
 
+func NewBubbleNode(x, y, size, value float32) *Node {
+	return &Node{X: x, Y: y, Size: size, Value: value}
+}
+
+func NewVectorNode(x, y, dx, dy float32) *Node {
+	return &Node{X: x, Y: y, DX: dx, DY: dy}
+}
+
+// isFiniteNode reports whether both coordinates of n are real numbers.
+// NaN or infinite coordinates mark a missing value.
+func isFiniteNode(n Node) bool {
+	return isFinite(n.X) && isFinite(n.Y)
+}
+
+// finiteNodes returns the nodes that are not missing values
+func finiteNodes(nodes []Node) []Node {
+	finite := make([]Node, 0, len(nodes))
+	for _, n := range nodes {
+		if isFiniteNode(n) {
+			finite = append(finite, n)
+		}
+	}
+	return finite
+}
+
 func MinY(plots []Plot) (float32, error) {
 	nodes := []Node{}
 
 	for _, p := range plots {
-		nodes = append(nodes, p.Nodes...)
+		nodes = append(nodes, finiteNodes(p.Nodes)...)
 	}
 
 	if len(nodes) == 0 {
@@ -37,7 +70,7 @@ func MaxY(plots []Plot) (float32, error) {
 	nodes := []Node{}
 
 	for _, p := range plots {
-		nodes = append(nodes, p.Nodes...)
+		nodes = append(nodes, finiteNodes(p.Nodes)...)
 	}
 
 	if len(nodes) == 0 {
@@ -59,7 +92,7 @@ func MinX(plots []Plot) (float32, error) {
 	nodes := []Node{}
 
 	for _, p := range plots {
-		nodes = append(nodes, p.Nodes...)
+		nodes = append(nodes, finiteNodes(p.Nodes)...)
 	}
 
 	if len(nodes) == 0 {
@@ -81,7 +114,7 @@ func MaxX(plots []Plot) (float32, error) {
 	nodes := []Node{}
 
 	for _, p := range plots {
-		nodes = append(nodes, p.Nodes...)
+		nodes = append(nodes, finiteNodes(p.Nodes)...)
 	}
 
 	if len(nodes) == 0 {
@@ -1 +1,67 @@
 package fynesimplechart_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/alexiusacademia/fynesimplechart"
+)
+
+// Missing nodes are left out of the bounds, and plots with nothing but
+// missing nodes do not count
+func TestBoundsSkipMissing(t *testing.T) {
+	nan := float32(math.NaN())
+	inf := float32(math.Inf(1))
+
+	tests := []struct {
+		name                   string
+		plots                  [][]fynesimplechart.Node
+		minX, maxX, minY, maxY float32
+		err                    bool
+	}{
+		{
+			name:  "leading and trailing gaps",
+			plots: [][]fynesimplechart.Node{{{X: nan, Y: 100}, {X: 1, Y: 2}, {X: 3, Y: -4}, {X: 5, Y: inf}}},
+			minX:  1, maxX: 3, minY: -4, maxY: 2,
+		},
+		{
+			name: "all-missing plot beside another",
+			plots: [][]fynesimplechart.Node{
+				{{X: nan, Y: nan}, {X: inf, Y: 7}},
+				{{X: -1, Y: 0}, {X: 1, Y: 1}},
+			},
+			minX: -1, maxX: 1, minY: 0, maxY: 1,
+		},
+		{
+			name:  "all missing",
+			plots: [][]fynesimplechart.Node{{{X: nan, Y: nan}, {X: 1, Y: nan}}},
+			err:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plots := []fynesimplechart.Plot{}
+			for _, nodes := range tt.plots {
+				plots = append(plots, *fynesimplechart.NewPlot(nodes, ""))
+			}
+
+			minX, errMinX := fynesimplechart.MinX(plots)
+			maxX, errMaxX := fynesimplechart.MaxX(plots)
+			minY, errMinY := fynesimplechart.MinY(plots)
+			maxY, errMaxY := fynesimplechart.MaxY(plots)
+			for _, err := range []error{errMinX, errMaxX, errMinY, errMaxY} {
+				if (err != nil) != tt.err {
+					t.Fatalf("error = %v, want error %v", err, tt.err)
+				}
+			}
+			if tt.err {
+				return
+			}
+
+			if minX != tt.minX || maxX != tt.maxX || minY != tt.minY || maxY != tt.maxY {
+				t.Errorf("bounds = %v..%v, %v..%v, want %v..%v, %v..%v", minX, maxX, minY, maxY, tt.minX, tt.maxX, tt.minY, tt.maxY)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package fynesimplechart
+
+import (
+	"fyne.io/fyne/v2"
+)
+
+// Overlay pins a canvas object, such as a button or an icon, to an anchor
+// on top of the chart. It is moved on every layout so it follows the data
+// when the range or the widget size changes, and it keeps receiving input.
+// Overlays whose anchor falls outside the plot area are left out.
+type Overlay struct {
+	Object fyne.CanvasObject
+	At     Anchor
+
+	Center bool          // Centre the object on the anchor instead of putting its top left corner there
+	Offset fyne.Position // Pixels to shift the object by from its anchored position
+	Size   fyne.Size     // Object size (zero uses its MinSize)
+}
+
+func NewOverlay(object fyne.CanvasObject, at Anchor) *Overlay {
+	overlay := &Overlay{
+		Object: object,
+		At:     at,
+		Center: false,
+		Offset: fyne.NewPos(0, 0),
+		Size:   fyne.NewSize(0, 0),
+	}
+
+	return overlay
+}
+
+// AddOverlay pins object to the anchor at and redraws the chart
+func (v *ScatterPlot) AddOverlay(object fyne.CanvasObject, at Anchor) {
+	v.Overlays = append(v.Overlays, *NewOverlay(object, at))
+	v.Refresh()
+}
+
+// Place the overlay objects on top of the drawn scene, returning those shown
+// and those that moved or changed size. Polar charts have no Cartesian
+// anchors, so overlays are left out there.
+func (r *scatterChartRenderer) layoutOverlays() (objects, moved []fyne.CanvasObject) {
+	if len(r.widget.Overlays) == 0 || r.widget.Polar || !r.widget.hasSeries() || !r.visible {
+		return nil, nil
+	}
+
+	toScreen, inside := anchorTransform(r.view.transform)
+
+	for _, o := range r.widget.Overlays {
+		if o.Object == nil {
+			continue
+		}
+		at := toScreen(o.At)
+		if !inside(at) {
+			continue
+		}
+
+		size := o.Size
+		if size.Width <= 0 || size.Height <= 0 {
+			size = o.Object.MinSize()
+		}
+		pos := at.Add(o.Offset)
+		if o.Center {
+			pos = pos.Subtract(fyne.NewPos(size.Width/2, size.Height/2))
+		}
+
+		if o.Object.Size() != size || o.Object.Position() != pos {
+			moved = append(moved, o.Object)
+		}
+		o.Object.Resize(size)
+		o.Object.Move(pos)
+		objects = append(objects, o.Object)
+	}
+	return objects, moved
+}
@@ -0,0 +1,359 @@
+package fynesimplechart
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/widget"
+)
+
+// PieLabelMode defines what is written next to each slice
+type PieLabelMode int
+
+const (
+	PieLabelNone    PieLabelMode = iota // Default: no slice labels
+	PieLabelValue                       // Slice value using LabelFormat
+	PieLabelPercent                     // Share of the total
+)
+
+// PieDirection defines which way slices are laid out from the start angle
+type PieDirection int
+
+const (
+	PieClockwise        PieDirection = iota // Default
+	PieCounterClockwise                     // Counter-clockwise
+)
+
+type PieSlice struct {
+	Label   string
+	Value   float32
+	Color   color.Color // nil uses auto-generated color
+	Explode float32     // Offset of the slice from the center as a fraction of the radius
+}
+
+func NewPieSlice(label string, value float32) *PieSlice {
+	return &PieSlice{Label: label, Value: value}
+}
+
+type PieChart struct {
+	widget.BaseWidget
+
+	Slices     []PieSlice
+	ChartTitle string
+
+	// Shape properties
+	Donut      bool         // Draw a ring instead of a full pie
+	HoleRatio  float32      // Inner radius of the donut as a fraction of the outer radius (0 to 0.95)
+	StartAngle float32      // Angle of the first slice edge in degrees, clockwise from 12 o'clock
+	Direction  PieDirection // Direction slices follow each other
+
+	// Label properties
+	LabelMode   PieLabelMode
+	LabelFormat string      // Format string for values (e.g., "%.1f", "$%.0f")
+	LabelColor  color.Color // Color for labels (nil uses theme foreground)
+	LabelSize   float32     // Font size for labels (0 = default 10)
+
+	// Slices smaller than this share of the total (0-1) are merged into one
+	OtherThreshold float32
+	OtherLabel     string
+
+	// Legend properties
+	LegendPosition LegendPosition // Where to display the legend
+	ShowLegend     bool           // Whether to show legend
+
+	mTop    float32
+	mBottom float32
+	mLeft   float32
+	mRight  float32
+}
+
+// Constructor
+func NewPieChart(slices []PieSlice) *PieChart {
+	w := &PieChart{
+		Slices:         slices,
+		ChartTitle:     "",
+		Donut:          false,
+		HoleRatio:      0.5,
+		StartAngle:     0,
+		Direction:      PieClockwise,
+		LabelMode:      PieLabelNone,
+		LabelFormat:    "%.1f",
+		LabelColor:     nil,
+		LabelSize:      10,
+		OtherThreshold: 0,
+		OtherLabel:     "Other",
+		LegendPosition: LegendRight,
+		ShowLegend:     true,
+		mTop:           defaultMarginTop,
+		mBottom:        defaultMarginBottom,
+		mLeft:          defaultMarginLeft,
+		mRight:         defaultMarginRight,
+	}
+	w.ExtendBaseWidget(w)
+	return w
+}
+
+// SetChartTitle sets the main title for the chart
+func (p *PieChart) SetChartTitle(title string) {
+	p.ChartTitle = title
+	p.Refresh()
+}
+
+// visibleSlices returns the slices to draw, with non-positive values dropped
+// and slices below OtherThreshold merged into a single trailing slice. Slices
+// without a color get the palette color of their index in Slices, so merging
+// some of them does not change the colors of the rest.
+func (p *PieChart) visibleSlices() []PieSlice {
+	colors := generateColors(len(p.Slices) + 1)
+
+	total := float32(0)
+	for _, s := range p.Slices {
+		if s.Value > 0 {
+			total += s.Value
+		}
+	}
+
+	slices := []PieSlice{}
+	other := PieSlice{Label: p.OtherLabel, Color: colors[len(p.Slices)]}
+	merged := 0
+
+	for i, s := range p.Slices {
+		if s.Value <= 0 {
+			continue
+		}
+		if s.Color == nil {
+			s.Color = colors[i]
+		}
+		if p.OtherThreshold > 0 && s.Value/total < p.OtherThreshold {
+			other.Value += s.Value
+			merged++
+			continue
+		}
+		slices = append(slices, s)
+	}
+
+	if merged > 0 {
+		slices = append(slices, other)
+	}
+
+	return slices
+}
+
+// Generates a new renderer for the PieChart.
+func (p *PieChart) CreateRenderer() fyne.WidgetRenderer {
+	p.ExtendBaseWidget(p)
+	r := &pieChartRenderer{widget: p}
+	r.sceneRenderer = sceneRenderer{owner: p, layout: r.layout}
+	return r
+}
+
+// Scene lays the chart out at the given size without drawing it, in the
+// colors of Fyne's dark theme. A nil measurer uses ApproximateTextSize, so
+// no running app is needed.
+func (p *PieChart) Scene(size fyne.Size, measure TextMeasurer) *Scene {
+	r := &pieChartRenderer{widget: p}
+	r.scene = newScene(size, measure)
+	r.layout()
+	return r.scene
+}
+
+// Responsible for rendering the PieChart.
+type pieChartRenderer struct {
+	sceneRenderer
+	widget *PieChart
+}
+
+// Calculates the minimum size of the chart.
+func (r *pieChartRenderer) MinSize() fyne.Size {
+	return r.widget.Size()
+}
+
+// Lay the chart out into the scene
+func (r *pieChartRenderer) layout() {
+	slices := r.widget.visibleSlices()
+	if len(slices) == 0 {
+		return
+	}
+
+	mTop, mBottom, mLeft, mRight := r.widget.mTop, r.widget.mBottom, r.widget.mLeft, r.widget.mRight
+	widgetSize := r.scene.Size
+
+	areaWidth := widgetSize.Width - mLeft - mRight
+	areaHeight := widgetSize.Height - mTop - mBottom
+	if areaWidth <= 0 || areaHeight <= 0 {
+		return
+	}
+
+	// Draw chart title if present
+	if r.widget.ChartTitle != "" {
+		titleText := r.scene.newText(r.widget.ChartTitle, r.scene.Foreground)
+		titleText.TextSize = 16
+		titleText.TextStyle.Bold = true
+		titleText.Alignment = fyne.TextAlignCenter
+		titleWidth := titleText.MinSize().Width
+		titleText.Move(fyne.NewPos((widgetSize.Width-titleWidth)/2, 10))
+		r.scene.addTo(layerLabels, titleText)
+	}
+
+	total := float32(0)
+	maxExplode := float32(0)
+	for _, s := range slices {
+		total += s.Value
+		maxExplode = float32(math.Max(float64(maxExplode), float64(s.Explode)))
+	}
+
+	// Leave room for exploded slices and outside labels
+	labelSpace := float32(0)
+	if r.widget.LabelMode != PieLabelNone {
+		labelSpace = 20
+	}
+	radius := float32(math.Min(float64(areaWidth), float64(areaHeight)))/2 - labelSpace
+	radius /= 1 + maxExplode
+	if radius <= 0 {
+		return
+	}
+	center := fyne.NewPos(mLeft+areaWidth/2, mTop+areaHeight/2)
+
+	innerRadius := float32(0)
+	if r.widget.Donut {
+		// Keep a ring to draw whatever the ratio
+		innerRadius = radius * float32(math.Max(0, math.Min(0.95, float64(r.widget.HoleRatio))))
+	}
+
+	sign := float64(1)
+	if r.widget.Direction == PieCounterClockwise {
+		sign = -1
+	}
+
+	angle := float64(r.widget.StartAngle) * math.Pi / 180
+
+	for _, s := range slices {
+		sweep := sign * 2 * math.Pi * float64(s.Value/total)
+		mid := angle + sweep/2
+
+		// Exploded slices move outward along their bisector
+		offset := s.Explode * radius
+		sliceCenter := fyne.NewPos(
+			center.X+offset*float32(math.Sin(mid)),
+			center.Y-offset*float32(math.Cos(mid)),
+		)
+
+		r.scene.add(newPolygon([][]fyne.Position{
+			pieSlicePath(sliceCenter, radius, innerRadius, angle, angle+sweep),
+		}, s.Color))
+
+		if r.widget.LabelMode != PieLabelNone {
+			r.drawSliceLabel(s, total, sliceCenter, radius, mid)
+		}
+
+		angle += sweep
+	}
+
+	// Draw legend
+	if r.widget.ShowLegend && r.widget.LegendPosition != LegendNone {
+		entries := []func(x, y float32){}
+		for _, s := range slices {
+			s := s
+			entries = append(entries, func(x, y float32) {
+				r.drawLegendItem(s, s.Color, x, y)
+			})
+		}
+
+		drawLegendLayout(r.widget.LegendPosition, r.widget.ChartTitle != "", entries, nil, widgetSize.Width, widgetSize.Height, mTop, mRight, mBottom, r.scene)
+	}
+}
+
+// pieSlicePath returns the outline of a slice between two angles measured
+// clockwise from 12 o'clock. A non-zero inner radius gives a ring segment.
+func pieSlicePath(center fyne.Position, radius, innerRadius float32, from, to float64) []fyne.Position {
+	// Roughly one segment per 2 degrees keeps arcs smooth
+	steps := int(math.Ceil(math.Abs(to-from) / (2 * math.Pi / 180)))
+	if steps < 1 {
+		steps = 1
+	}
+
+	pointAt := func(r float32, a float64) fyne.Position {
+		return fyne.NewPos(center.X+r*float32(math.Sin(a)), center.Y-r*float32(math.Cos(a)))
+	}
+
+	path := []fyne.Position{}
+	for i := 0; i <= steps; i++ {
+		path = append(path, pointAt(radius, from+(to-from)*float64(i)/float64(steps)))
+	}
+
+	if innerRadius <= 0 {
+		return append(path, center)
+	}
+
+	for i := steps; i >= 0; i-- {
+		path = append(path, pointAt(innerRadius, from+(to-from)*float64(i)/float64(steps)))
+	}
+	return path
+}
+
+// Draw the label of a slice just outside its outer edge
+func (r *pieChartRenderer) drawSliceLabel(s PieSlice, total float32, center fyne.Position, radius float32, mid float64) {
+	labelColor := r.widget.LabelColor
+	if labelColor == nil {
+		labelColor = r.scene.Foreground
+	}
+
+	labelSize := r.widget.LabelSize
+	if labelSize == 0 {
+		labelSize = 10
+	}
+
+	labelFormat := r.widget.LabelFormat
+	if labelFormat == "" {
+		labelFormat = "%.1f"
+	}
+
+	var labelText string
+	switch r.widget.LabelMode {
+	case PieLabelValue:
+		labelText = fmt.Sprintf(labelFormat, s.Value)
+	case PieLabelPercent:
+		labelText = fmt.Sprintf("%.1f%%", s.Value/total*100)
+	}
+
+	label := r.scene.newText(labelText, labelColor)
+	label.TextSize = labelSize
+	labelWidth := label.MinSize().Width
+	labelHeight := label.MinSize().Height
+
+	anchorX := center.X + (radius+6)*float32(math.Sin(mid))
+	anchorY := center.Y - (radius+6)*float32(math.Cos(mid))
+
+	// Grow the label away from the pie on either side
+	labelX := anchorX
+	if math.Sin(mid) < -0.1 {
+		labelX -= labelWidth
+	} else if math.Sin(mid) <= 0.1 {
+		labelX -= labelWidth / 2
+	}
+	labelY := anchorY - labelHeight/2
+	if math.Cos(mid) > 0.9 {
+		labelY -= labelHeight / 2
+	} else if math.Cos(mid) < -0.9 {
+		labelY += labelHeight / 2
+	}
+
+	label.Move(fyne.NewPos(labelX, labelY))
+	r.scene.add(label)
+}
+
+// Draw a single legend item
+func (r *pieChartRenderer) drawLegendItem(s PieSlice, sliceColor color.Color, x, y float32) {
+	rect := r.scene.newRectangle(sliceColor)
+	rect.Resize(fyne.NewSize(12, 12))
+	rect.Move(fyne.NewPos(x+10, y+2))
+	r.scene.add(rect)
+
+	// Label
+	label := r.scene.newText(s.Label, r.scene.Foreground)
+	label.TextSize = 10
+	label.Move(fyne.NewPos(x+30, y))
+	r.scene.add(label)
+}
@@ -0,0 +1,29 @@
+package fynesimplechart
+
+import (
+	"testing"
+)
+
+// Merging small slices into "Other" leaves the colors of the rest as they
+// would be without merging
+func TestPieSliceColors(t *testing.T) {
+	chart := NewPieChart([]PieSlice{
+		*NewPieSlice("a", 50), *NewPieSlice("small", 1), *NewPieSlice("b", 40), *NewPieSlice("c", 30),
+	})
+	chart.OtherThreshold = 0.05
+	palette := generateColors(len(chart.Slices) + 1)
+
+	slices := chart.visibleSlices()
+	want := []struct {
+		label string
+		color int // Palette index
+	}{{"a", 0}, {"b", 2}, {"c", 3}, {"Other", 4}}
+	if len(slices) != len(want) {
+		t.Fatalf("%d slices, want %d", len(slices), len(want))
+	}
+	for i, w := range want {
+		if slices[i].Label != w.label || !sameColor(slices[i].Color, palette[w.color]) {
+			t.Errorf("slice %d = %q in %v, want %q in palette color %d", i, slices[i].Label, slices[i].Color, w.label, w.color)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package fynesimplechart_test
+
+import (
+	"math"
+	"testing"
+
+	"fyne.io/fyne/v2"
+
+	"github.com/alexiusacademia/fynesimplechart"
+)
+
+// Hole ratios outside the range still draw a ring inside the pie's radius
+func TestPieHoleRatioClamped(t *testing.T) {
+	// Smallest and largest distance of the slice outlines from the center
+	ring := func(ratio float32) (inner, outer float64) {
+		slices := []fynesimplechart.PieSlice{*fynesimplechart.NewPieSlice("a", 1), *fynesimplechart.NewPieSlice("b", 1)}
+		chart := fynesimplechart.NewPieChart(slices)
+		chart.Donut = true
+		chart.HoleRatio = ratio
+		chart.ShowLegend = false
+		scene := chart.Scene(fyne.NewSize(300, 300), nil)
+
+		points := []fyne.Position{}
+		for _, primitive := range scene.Primitives {
+			if polygon, ok := primitive.(*fynesimplechart.ScenePolygon); ok {
+				points = append(points, polygon.Paths[0]...)
+			}
+		}
+
+		// Two equal slices make a full circle, centered in its bounding box
+		minX, minY := math.Inf(1), math.Inf(1)
+		maxX, maxY := math.Inf(-1), math.Inf(-1)
+		for _, p := range points {
+			minX, maxX = math.Min(minX, float64(p.X)), math.Max(maxX, float64(p.X))
+			minY, maxY = math.Min(minY, float64(p.Y)), math.Max(maxY, float64(p.Y))
+		}
+		centerX, centerY := (minX+maxX)/2, (minY+maxY)/2
+
+		inner = math.Inf(1)
+		for _, p := range points {
+			d := math.Hypot(float64(p.X)-centerX, float64(p.Y)-centerY)
+			inner, outer = math.Min(inner, d), math.Max(outer, d)
+		}
+		return inner, outer
+	}
+
+	_, radius := ring(0)
+	for _, ratio := range []float32{-1, 0.5, 1, 3} {
+		inner, outer := ring(ratio)
+		if math.Abs(outer-radius) > 0.5 || inner > 0.96*radius {
+			t.Errorf("hole ratio %v: ring from %v to %v, want inside radius %v", ratio, inner, outer, radius)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package fynesimplechart
 
-import "image/color"
+import (
+	"image/color"
+	"math"
+)
 
 type Plot struct {
 	Nodes      []Node
@@ -15,6 +18,27 @@ type Plot struct {
 	PlotColor  color.Color
 	ShowPoints bool
 
+	// Line style properties
+	LineStyle   LineStyle // Solid, dashed, dotted, dash-dot or custom
+	DashPattern []float32 // On/off lengths in pixels for LineCustom
+
+	// Missing data properties (nodes with NaN or Inf coordinates are gaps)
+	ConnectGaps int     // Bridge gaps of up to this many missing nodes (0 = always break)
+	MaxGapX     float32 // Break the line where neighbours are further apart in X (0 = no limit)
+
+	// Downsampling properties, for series with far more nodes than pixels.
+	// Markers are drawn at the nodes kept; series showing bars or data
+	// labels are never thinned
+	Downsample          DownsampleMode // How visible nodes are thinned before drawing
+	DownsampleThreshold int            // Nodes above which the series is thinned (0 = twice the plot width in pixels)
+
+	// Marker properties
+	Marker            MarkerShape // Symbol drawn at each point
+	MarkerHollow      bool        // Draw only the outline of filled markers
+	MarkerFillColor   color.Color // Marker fill (nil uses PlotColor)
+	MarkerStrokeColor color.Color // Marker outline (nil uses PlotColor)
+	MarkerStrokeWidth float32     // Marker outline width
+
 	// Area fill properties
 	FillArea      bool        // Enable area fill
 	FillColor     color.Color // Color for fill (nil uses PlotColor with transparency)
@@ -32,6 +56,18 @@ type Plot struct {
 	LabelFormat    string      // Format string for labels (e.g., "%.1f", "%.0f%%")
 	LabelColor     color.Color // Color for labels (nil uses theme foreground)
 	LabelSize      float32     // Font size for labels (0 = default 10)
+
+	// Bubble properties
+	SizeScale      SizeScale  // How Node.Size maps to point radius (SizeFixed uses PointSize)
+	MinPointSize   float32    // Smallest point radius for SizeLinear
+	MaxPointSize   float32    // Largest point radius
+	ColorByValue   bool       // Color points by Node.Value
+	ColorScale     ColorScale // Maps Node.Value to colors
+	ShowSizeLegend bool       // Show a size key in the legend area
+	ShowColorBar   bool       // Show a colour bar in the legend area
+
+	// Trendline properties
+	Trendlines []Trendline // Fitted curves drawn over the series
 }
 
 func NewPlot(nodes []Node, title string) *Plot {
@@ -56,7 +92,65 @@ func NewPlot(nodes []Node, title string) *Plot {
 		LabelFormat:    "%.1f",
 		LabelColor:     nil,
 		LabelSize:      10,
+		SizeScale:      SizeFixed,
+		MinPointSize:   3,
+		MaxPointSize:   15,
+		ColorByValue:   false,
+		ColorScale:     NewSequentialScale(ColormapViridis),
+		ShowSizeLegend: false,
+		ShowColorBar:   false,
+
+		Marker:            MarkerCircle,
+		MarkerHollow:      false,
+		MarkerFillColor:   nil,
+		MarkerStrokeColor: nil,
+		MarkerStrokeWidth: 1,
+
+		LineStyle:   LineSolid,
+		DashPattern: nil,
+		ConnectGaps: 0,
+		MaxGapX:     0,
+
+		Downsample:          DownsampleNone,
+		DownsampleThreshold: 0, // Will follow the plot width if 0
+
+		Trendlines: nil,
 	}
 
 	return plot
 }
+
+// lineRuns splits the plot's nodes into runs that are drawn as connected
+// lines. Runs break at missing values, unless the gap is no more than
+// ConnectGaps nodes long, and wherever X jumps by more than MaxGapX.
+func (p Plot) lineRuns() [][]Node {
+	runs := [][]Node{}
+	current := []Node{}
+	missing := 0
+
+	for _, n := range p.Nodes {
+		if !isFiniteNode(n) {
+			missing++
+			continue
+		}
+
+		if len(current) > 0 {
+			last := current[len(current)-1]
+			gapTooLong := missing > p.ConnectGaps
+			jumpTooWide := p.MaxGapX > 0 && float32(math.Abs(float64(n.X-last.X))) > p.MaxGapX
+			if gapTooLong || jumpTooWide {
+				runs = append(runs, current)
+				current = []Node{}
+			}
+		}
+
+		missing = 0
+		current = append(current, n)
+	}
+
+	if len(current) > 0 {
+		runs = append(runs, current)
+	}
+
+	return runs
+}
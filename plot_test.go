@@ -0,0 +1,92 @@
+package fynesimplechart
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+// Lines break at runs of missing nodes longer than ConnectGaps and at X
+// jumps wider than MaxGapX
+func TestLineRuns(t *testing.T) {
+	nan := float32(math.NaN())
+
+	tests := []struct {
+		name        string
+		x           []float32 // Node X, also used as Y; NaN is a missing node
+		connectGaps int
+		maxGapX     float32
+		want        [][]float32 // X of the nodes of each run
+	}{
+		{
+			name: "leading and trailing gaps",
+			x:    []float32{nan, nan, 1, 2, 3, nan},
+			want: [][]float32{{1, 2, 3}},
+		},
+		{
+			name: "gap breaks by default",
+			x:    []float32{0, 1, nan, 3},
+			want: [][]float32{{0, 1}, {3}},
+		},
+		{
+			name:        "gap of ConnectGaps nodes",
+			x:           []float32{0, 1, nan, nan, 4},
+			connectGaps: 2,
+			want:        [][]float32{{0, 1, 4}},
+		},
+		{
+			name:        "gap of one more",
+			x:           []float32{0, 1, nan, nan, nan, 5},
+			connectGaps: 2,
+			want:        [][]float32{{0, 1}, {5}},
+		},
+		{
+			name:    "jump wider than MaxGapX",
+			x:       []float32{0, 1, 5, 6},
+			maxGapX: 2,
+			want:    [][]float32{{0, 1}, {5, 6}},
+		},
+		{
+			name:    "jump of MaxGapX",
+			x:       []float32{0, 2, 4},
+			maxGapX: 2,
+			want:    [][]float32{{0, 2, 4}},
+		},
+		{
+			name:        "jump across a bridged gap",
+			x:           []float32{0, nan, 10},
+			connectGaps: 1,
+			maxGapX:     5,
+			want:        [][]float32{{0}, {10}},
+		},
+		{
+			name: "all missing",
+			x:    []float32{nan, nan, nan},
+			want: [][]float32{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodes := make([]Node, len(tt.x))
+			for i, x := range tt.x {
+				nodes[i] = Node{X: x, Y: x}
+			}
+			plot := NewPlot(nodes, "")
+			plot.ConnectGaps = tt.connectGaps
+			plot.MaxGapX = tt.maxGapX
+
+			got := [][]float32{}
+			for _, run := range plot.lineRuns() {
+				xs := []float32{}
+				for _, n := range run {
+					xs = append(xs, n.X)
+				}
+				got = append(got, xs)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("runs = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
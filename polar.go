@@ -0,0 +1,407 @@
+package fynesimplechart
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+)
+
+// AngleUnit defines how Node.X is read in polar mode
+type AngleUnit int
+
+const (
+	AngleDegrees AngleUnit = iota // Default: full turn is 360
+	AngleRadians                  // Full turn is 2π
+)
+
+// PolarDirection defines where angle zero points in polar mode
+type PolarDirection int
+
+const (
+	PolarEast  PolarDirection = iota // Default: to the right, as in mathematics
+	PolarNorth                       // Up, as on a compass
+	PolarWest                        // To the left
+	PolarSouth                       // Down
+)
+
+// fullTurn returns the angle of one full turn in the given unit
+func (u AngleUnit) fullTurn() float64 {
+	if u == AngleRadians {
+		return 2 * math.Pi
+	}
+	return 360
+}
+
+// maxPolarGridLines is the most rings or spokes drawn. Tick intervals that
+// would need more are replaced by the automatic ones.
+const maxPolarGridLines = 360
+
+// radialRange returns the radius at the pole and at the outer ring along with
+// the ring spacing. Automatic ranges start at zero and end on a ring.
+func (v *ScatterPlot) radialRange() (lo, hi, interval float32, ok bool) {
+	for _, plot := range v.Plots {
+		for _, n := range finiteNodes(plot.Nodes) {
+			if !ok {
+				hi, ok = n.Y, true
+			}
+			hi = float32(math.Max(float64(hi), float64(n.Y)))
+		}
+	}
+	if !ok {
+		return 0, 0, 0, false
+	}
+
+	if v.MinY != nil {
+		lo = *v.MinY
+	}
+	if v.MaxY != nil {
+		hi = *v.MaxY
+	}
+	if hi <= lo {
+		hi = lo + 1
+	}
+
+	if v.YTickInterval != nil && *v.YTickInterval > 0 && (hi-lo) / *v.YTickInterval <= maxPolarGridLines {
+		interval = *v.YTickInterval
+	} else {
+		interval = calculateNiceInterval(hi-lo, 5)
+	}
+
+	if v.MaxY == nil {
+		hi = lo + float32(math.Ceil(float64((hi-lo)/interval)))*interval
+	}
+
+	return lo, hi, interval, true
+}
+
+// polarFrame maps polar data to the screen: angles around the pole and radii
+// out to the outer ring
+type polarFrame struct {
+	center     fyne.Position
+	radius     float32 // Screen radius of the outer ring
+	minR, maxR float32 // Data radii at the pole and the outer ring
+	interval   float32 // Ring spacing
+
+	zeroOffset float64 // Screen angle of data angle zero
+	sense      float64 // 1 counter-clockwise, -1 clockwise
+	turn       float64 // Data angle of one full turn
+}
+
+// polarFrame returns the frame for a chart of the given size. Without data
+// the radii run from 0 to 1 and ok is false, as it is without room to draw.
+func (v *ScatterPlot) polarFrame(size fyne.Size) (frame polarFrame, ok bool) {
+	frame.minR, frame.maxR, frame.interval = 0, 1, 1
+	if minR, maxR, interval, found := v.radialRange(); found {
+		frame.minR, frame.maxR, frame.interval, ok = minR, maxR, interval, true
+	}
+
+	// Leave room for the angle labels
+	pos, area := v.plotArea(size)
+	frame.radius = float32(math.Min(float64(area.Width), float64(area.Height)))/2 - 20
+	frame.center = fyne.NewPos(pos.X+area.Width/2, pos.Y+area.Height/2)
+
+	frame.zeroOffset = float64(v.ZeroDirection) * math.Pi / 2
+	frame.sense = 1
+	if v.Clockwise {
+		frame.sense = -1
+	}
+	frame.turn = v.AngleUnit.fullTurn()
+
+	return frame, ok && frame.radius > 0
+}
+
+// screenAngle returns the screen angle, counter-clockwise from the right, of
+// a data angle
+func (f polarFrame) screenAngle(angle float32) float64 {
+	return f.zeroOffset + f.sense*float64(angle)/f.turn*2*math.Pi
+}
+
+// position returns the screen position of a data angle and radius
+func (f polarFrame) position(angle, value float32) fyne.Position {
+	t := (value - f.minR) / (f.maxR - f.minR)
+	a := f.screenAngle(angle)
+	return fyne.NewPos(f.center.X+t*f.radius*float32(math.Cos(a)), f.center.Y-t*f.radius*float32(math.Sin(a)))
+}
+
+// data returns the data angle, from zero to a full turn, and radius at a
+// screen position
+func (f polarFrame) data(screen fyne.Position) (angle, value float32) {
+	dx, dy := float64(screen.X-f.center.X), float64(f.center.Y-screen.Y)
+
+	turns := (math.Atan2(dy, dx) - f.zeroOffset) * f.sense / (2 * math.Pi)
+	turns -= math.Floor(turns)
+	angle = float32(turns * f.turn)
+	value = f.minR + float32(math.Hypot(dx, dy))/f.radius*(f.maxR-f.minR)
+	return angle, value
+}
+
+// Render the chart in polar coordinates
+func (r *scatterChartRenderer) renderPolar() {
+	mTop, mBottom, mLeft, mRight := r.widget.mTop, r.widget.mBottom, r.widget.mLeft, r.widget.mRight
+	widgetSize := r.scene.Size
+
+	frame, ok := r.widget.polarFrame(widgetSize)
+	r.view = chartView{polar: true, frame: frame}
+	if !ok {
+		return
+	}
+	minR, maxR, ringInterval := frame.minR, frame.maxR, frame.interval
+	center, radius := frame.center, frame.radius
+
+	// Draw chart title if present
+	if r.widget.ChartTitle != "" {
+		titleText := r.scene.newText(r.widget.ChartTitle, r.scene.Foreground)
+		titleText.TextSize = 16
+		titleText.TextStyle.Bold = true
+		titleText.Alignment = fyne.TextAlignCenter
+		titleWidth := titleText.MinSize().Width
+		titleText.Move(fyne.NewPos((widgetSize.Width-titleWidth)/2, 10))
+		r.scene.addTo(layerLabels, titleText)
+	}
+
+	turn := frame.turn
+	screenAngle := frame.screenAngle
+
+	// Transform function from polar data coordinates to screen coordinates.
+	// Radii outside the range are clamped to the pole and the outer ring.
+	toScreen := func(angle, value float32) fyne.Position {
+		value = float32(math.Max(float64(minR), math.Min(float64(maxR), float64(value))))
+		return frame.position(angle, value)
+	}
+
+	r.scene.setLayer(layerGrid)
+	r.drawPolarGrid(minR, maxR, ringInterval, center, radius, screenAngle, toScreen)
+	r.scene.setLayer(layerSeries)
+
+	// Generate colors for plots
+	colors := generateColors(len(r.widget.Plots) + len(r.widget.BoxPlots) + len(r.widget.Waterfalls) + len(r.widget.Quivers) + len(r.widget.curveStyles()) + len(r.widget.DerivedSeries))
+
+	// Draw area fills first (so they appear behind everything)
+	for i, plot := range r.widget.Plots {
+		plotColor := colors[i]
+		if plot.PlotColor != nil {
+			plotColor = plot.PlotColor
+		}
+
+		if plot.FillArea {
+			r.drawPolarFill(plot, plotColor, center, toScreen)
+		}
+	}
+
+	// Draw each plot (lines and points on top of fills)
+	for i, plot := range r.widget.Plots {
+		plotColor := colors[i]
+		if plot.PlotColor != nil {
+			plotColor = plot.PlotColor
+		}
+
+		if plot.ShowLine {
+			pattern := dashPattern(plot.LineStyle, plot.DashPattern, plot.LineWidth)
+			for _, run := range plot.lineRuns() {
+				r.drawPolyline(polarPath(run, turn, toScreen), plotColor, plot.LineWidth, pattern)
+			}
+		}
+
+		if plot.ShowPoints {
+			r.drawPoints(plot, plot.pointDomains(), plotColor, func(n Node) fyne.Position {
+				return toScreen(n.X, n.Y)
+			})
+		}
+	}
+
+	// Draw legend
+	if r.widget.ShowLegend && r.widget.LegendPosition != LegendNone {
+		r.drawLegend(colors, widgetSize.Width, widgetSize.Height, mLeft, mTop, mRight, mBottom)
+	}
+}
+
+// polarPath returns the screen points of a run. Segments are subdivided so
+// lines between distant angles follow the arc instead of cutting the chord.
+func polarPath(run []Node, turn float64, toScreen func(angle, value float32) fyne.Position) []fyne.Position {
+	if len(run) == 0 {
+		return nil
+	}
+
+	points := []fyne.Position{toScreen(run[0].X, run[0].Y)}
+	for k := 1; k < len(run); k++ {
+		prev, next := run[k-1], run[k]
+
+		// One step per 2 degrees of sweep
+		steps := int(math.Ceil(math.Abs(float64(next.X-prev.X)) / turn * 180))
+		steps = int(math.Max(1, math.Min(float64(steps), 180)))
+
+		for s := 1; s <= steps; s++ {
+			t := float32(s) / float32(steps)
+			points = append(points, toScreen(prev.X+t*(next.X-prev.X), prev.Y+t*(next.Y-prev.Y)))
+		}
+	}
+	return points
+}
+
+// Draw the area of a polar plot, either to the pole or to another plot
+func (r *scatterChartRenderer) drawPolarFill(plot Plot, plotColor color.Color, center fyne.Position, toScreen func(angle, value float32) fyne.Position) {
+	// Fills break wherever the line breaks
+	runs := plot.lineRuns()
+	if len(runs) == 0 {
+		return
+	}
+
+	turn := r.widget.AngleUnit.fullTurn()
+
+	// Determine fill color (use custom or derive from plot color with transparency)
+	fillColor := plot.FillColor
+	if fillColor == nil {
+		fillColor = translucent(plotColor)
+	}
+
+	// Fill to the pole
+	if plot.FillToZero {
+		for _, run := range runs {
+			if len(run) < 2 {
+				continue
+			}
+
+			outline := append([]fyne.Position{center}, polarPath(run, turn, toScreen)...)
+			r.scene.add(newPolygon([][]fyne.Position{outline}, fillColor))
+		}
+	}
+
+	// Fill between two plots
+	if plot.FillToPlotIdx >= 0 && plot.FillToPlotIdx < len(r.widget.Plots) {
+		otherRuns := r.widget.Plots[plot.FillToPlotIdx].lineRuns()
+
+		for _, run := range runs {
+			for _, otherRun := range otherRuns {
+				if len(run) < 2 || len(otherRun) < 2 {
+					continue
+				}
+
+				// Find common angle range
+				from := float32(math.Max(float64(run[0].X), float64(otherRun[0].X)))
+				to := float32(math.Min(float64(run[len(run)-1].X), float64(otherRun[len(otherRun)-1].X)))
+				if from >= to {
+					continue
+				}
+
+				outline := polarPath(clipRun(run, from, to), turn, toScreen)
+				other := polarPath(clipRun(otherRun, from, to), turn, toScreen)
+				for k := len(other) - 1; k >= 0; k-- {
+					outline = append(outline, other[k])
+				}
+				r.scene.add(newPolygon([][]fyne.Position{outline}, fillColor))
+			}
+		}
+	}
+}
+
+// clipRun returns the part of a run between two X values, interpolating the
+// end points
+func clipRun(run []Node, from, to float32) []Node {
+	clipped := []Node{{X: from, Y: interpolateY(run, from)}}
+	for _, n := range run {
+		if n.X > from && n.X < to {
+			clipped = append(clipped, n)
+		}
+	}
+	return append(clipped, Node{X: to, Y: interpolateY(run, to)})
+}
+
+// Draw the rings, spokes and their labels
+func (r *scatterChartRenderer) drawPolarGrid(minR, maxR, ringInterval float32, center fyne.Position, radius float32, screenAngle func(float32) float64, toScreen func(angle, value float32) fyne.Position) {
+	gridColor := color.RGBA{R: 128, G: 128, B: 128, A: 50}
+	foregroundColor := r.scene.Foreground
+
+	turn := float32(r.widget.AngleUnit.fullTurn())
+	spokeInterval := turn / 12
+	if r.widget.XTickInterval != nil && *r.widget.XTickInterval > 0 && turn / *r.widget.XTickInterval <= maxPolarGridLines {
+		spokeInterval = *r.widget.XTickInterval
+	}
+
+	// Rings, with the outer one drawn as the axis. Rings and spokes are
+	// counted with integers, as adding a tiny interval to a float32 can stall.
+	rings := int(math.Min(math.Floor(float64((maxR-minR)/ringInterval)+1.0/1000), maxPolarGridLines))
+	for i := 1; i <= rings; i++ {
+		value := minR + float32(i)*ringInterval
+		outer := value >= maxR-ringInterval/1000
+		if !r.widget.ShowGrid && !outer {
+			continue
+		}
+
+		ringRadius := (value - minR) / (maxR - minR) * radius
+		ring := r.scene.newCircle(color.Transparent)
+		ring.StrokeColor = gridColor
+		ring.StrokeWidth = gridLineWidth
+		if outer {
+			ring.StrokeColor = foregroundColor
+			ring.StrokeWidth = axisLineWidth
+		}
+		ring.Resize(fyne.NewSize(ringRadius*2, ringRadius*2))
+		ring.Move(fyne.NewPos(center.X-ringRadius, center.Y-ringRadius))
+		r.scene.add(ring)
+
+		// Ring labels run along the zero spoke
+		label := r.scene.newText(formatAxisLabel(value), foregroundColor)
+		label.TextSize = 10
+		pos := toScreen(0, value)
+		label.Move(fyne.NewPos(pos.X+3, pos.Y-label.MinSize().Height))
+		r.scene.add(label)
+	}
+
+	// Spokes with angle labels outside the outer ring
+	spokes := int(math.Min(math.Ceil(float64(turn/spokeInterval)-1.0/1000), maxPolarGridLines))
+	for i := 0; i < spokes; i++ {
+		angle := float32(i) * spokeInterval
+		if r.widget.ShowGrid {
+			spoke := r.scene.newLine(gridColor)
+			spoke.StrokeWidth = gridLineWidth
+			spoke.Position1 = center
+			spoke.Position2 = toScreen(angle, maxR)
+			r.scene.add(spoke)
+		}
+
+		label := r.scene.newText(r.formatAngle(angle), foregroundColor)
+		label.TextSize = 10
+		labelWidth := label.MinSize().Width
+		labelHeight := label.MinSize().Height
+
+		a := screenAngle(angle)
+		offset := radius + 6 + labelWidth/2
+		label.Move(fyne.NewPos(
+			center.X+offset*float32(math.Cos(a))-labelWidth/2,
+			center.Y-(radius+6+labelHeight/2)*float32(math.Sin(a))-labelHeight/2,
+		))
+		r.scene.add(label)
+	}
+}
+
+// formatAngle formats an angle label, as multiples of π for radians
+func (r *scatterChartRenderer) formatAngle(angle float32) string {
+	if r.widget.AngleUnit != AngleRadians {
+		return fmt.Sprintf("%g°", math.Round(float64(angle)*100)/100)
+	}
+
+	if angle == 0 {
+		return "0"
+	}
+
+	ratio := float64(angle) / math.Pi
+	for den := 1; den <= 12; den++ {
+		num := math.Round(ratio * float64(den))
+		if math.Abs(ratio*float64(den)-num) > 1e-3 {
+			continue
+		}
+
+		numText := fmt.Sprintf("%g", num)
+		if num == 1 {
+			numText = ""
+		}
+		if den == 1 {
+			return numText + "π"
+		}
+		return fmt.Sprintf("%sπ/%d", numText, den)
+	}
+
+	return formatAxisLabel(angle)
+}
@@ -0,0 +1,50 @@
+package fynesimplechart_test
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+
+	"github.com/alexiusacademia/fynesimplechart"
+)
+
+// Tick intervals too small to draw fall back to the automatic ones instead
+// of stalling the grid loops
+func TestPolarTinyTickIntervals(t *testing.T) {
+	tiny := float32(1e-7)
+	plot := fynesimplechart.NewPlot([]fynesimplechart.Node{{X: 0, Y: 1e6}, {X: 90, Y: 2e6}}, "plot")
+	chart := fynesimplechart.NewGraphWidget([]fynesimplechart.Plot{*plot})
+	chart.Polar = true
+	chart.XTickInterval = &tiny
+	chart.YTickInterval = &tiny
+
+	lines := 0
+	for _, primitive := range chart.Scene(fyne.NewSize(400, 300), nil).Primitives {
+		if _, ok := primitive.(*fynesimplechart.SceneLine); ok {
+			lines++
+		}
+	}
+	if lines == 0 || lines > 2000 {
+		t.Errorf("%d lines drawn", lines)
+	}
+}
+
+// The legend of a polar chart lists only the series it draws
+func TestPolarLegend(t *testing.T) {
+	plot := fynesimplechart.NewPlot([]fynesimplechart.Node{{X: 0, Y: 1}, {X: 90, Y: 2}}, "plot")
+	chart := fynesimplechart.NewGraphWidget([]fynesimplechart.Plot{*plot})
+	box := fynesimplechart.NewBoxPlot([]fynesimplechart.BoxCategory{{Label: "a", Samples: []float32{1, 2, 3}}}, "box")
+	chart.BoxPlots = []fynesimplechart.BoxPlot{*box}
+	chart.Heatmaps = []fynesimplechart.Heatmap{*fynesimplechart.NewHeatmap([][]float32{{0, 1}, {1, 0}}, nil, nil, "heat")}
+	chart.Polar = true
+
+	texts := map[string]bool{}
+	for _, primitive := range chart.Scene(fyne.NewSize(400, 300), nil).Primitives {
+		if text, ok := primitive.(*fynesimplechart.SceneText); ok {
+			texts[text.Text] = true
+		}
+	}
+	if !texts["LEGEND"] || !texts["plot"] || texts["box"] || texts["heat"] {
+		t.Errorf("texts = %v, want a legend of plot only", texts)
+	}
+}
@@ -0,0 +1,151 @@
+package fynesimplechart
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"golang.org/x/image/vector"
+)
+
+// newPolygon returns a polygon primitive filling the given closed paths, in
+// widget coordinates. Paths wound in opposite directions cancel out, so a
+// ring is an outer path plus a reversed inner path.
+func newPolygon(paths [][]fyne.Position, fill color.Color) *ScenePolygon {
+	return &ScenePolygon{Paths: paths, FillColor: fill}
+}
+
+// polygonObject is a raster that draws a polygon primitive with an
+// anti-aliased fill. It is kept between renders, so an unchanged polygon is
+// not rasterized again.
+type polygonObject struct {
+	raster *canvas.Raster
+	paths  [][]fyne.Position // Paths in widget coordinates, as last drawn
+	fill   color.Color
+	local  [][]fyne.Position // Paths relative to the raster
+	size   fyne.Size
+}
+
+func newPolygonObject() *polygonObject {
+	o := &polygonObject{}
+	o.raster = canvas.NewRaster(func(w, h int) image.Image {
+		return rasterizePolygon(o.local, o.size, w, h, o.fill)
+	})
+	return o
+}
+
+// draw fits the raster to a polygon primitive and reports whether it changed
+func (o *polygonObject) draw(p *ScenePolygon) bool {
+	if samePaths(o.paths, p.Paths) && sameColor(o.fill, p.FillColor) {
+		return false
+	}
+	o.paths, o.fill = p.Paths, p.FillColor
+
+	minX, minY := float32(math.MaxFloat32), float32(math.MaxFloat32)
+	maxX, maxY := float32(-math.MaxFloat32), float32(-math.MaxFloat32)
+	for _, path := range p.Paths {
+		for _, pos := range path {
+			minX = float32(math.Min(float64(minX), float64(pos.X)))
+			minY = float32(math.Min(float64(minY), float64(pos.Y)))
+			maxX = float32(math.Max(float64(maxX), float64(pos.X)))
+			maxY = float32(math.Max(float64(maxY), float64(pos.Y)))
+		}
+	}
+
+	if minX > maxX {
+		o.local, o.size = nil, fyne.Size{}
+		o.raster.Move(fyne.Position{})
+		o.raster.Resize(fyne.Size{})
+		return true
+	}
+
+	// Pad by a pixel so anti-aliased edges are not clipped
+	origin := fyne.NewPos(float32(math.Floor(float64(minX)))-1, float32(math.Floor(float64(minY)))-1)
+	o.size = fyne.NewSize(float32(math.Ceil(float64(maxX)))+1-origin.X, float32(math.Ceil(float64(maxY)))+1-origin.Y)
+
+	o.local = make([][]fyne.Position, len(p.Paths))
+	for i, path := range p.Paths {
+		o.local[i] = make([]fyne.Position, len(path))
+		for j, pos := range path {
+			o.local[i][j] = fyne.NewPos(pos.X-origin.X, pos.Y-origin.Y)
+		}
+	}
+
+	o.raster.Move(origin)
+	o.raster.Resize(o.size)
+	return true
+}
+
+// samePaths reports whether two sets of paths have the same points
+func samePaths(a, b [][]fyne.Position) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if len(a[i]) != len(b[i]) {
+			return false
+		}
+		for j := range a[i] {
+			if a[i][j] != b[i][j] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// rasterizePolygon fills paths laid out in a box of the given size into a
+// w×h pixel image, scaling to the output resolution.
+func rasterizePolygon(paths [][]fyne.Position, size fyne.Size, w, h int, fill color.Color) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	if w <= 0 || h <= 0 || size.Width <= 0 || size.Height <= 0 {
+		return img
+	}
+
+	sx := float32(w) / size.Width
+	sy := float32(h) / size.Height
+
+	z := vector.NewRasterizer(w, h)
+	for _, path := range paths {
+		if len(path) < 3 {
+			continue
+		}
+		z.MoveTo(path[0].X*sx, path[0].Y*sy)
+		for _, p := range path[1:] {
+			z.LineTo(p.X*sx, p.Y*sy)
+		}
+		z.ClosePath()
+	}
+
+	z.Draw(img, img.Bounds(), image.NewUniform(fill), image.Point{})
+	return img
+}
+
+// clipPolygon cuts a closed path to the rectangle from (left, top) to
+// (right, bottom), one edge at a time (Sutherland–Hodgman)
+func clipPolygon(path []fyne.Position, left, top, right, bottom float32) []fyne.Position {
+	path = clipPolygonEdge(path, func(p fyne.Position) float32 { return p.X - left })
+	path = clipPolygonEdge(path, func(p fyne.Position) float32 { return right - p.X })
+	path = clipPolygonEdge(path, func(p fyne.Position) float32 { return p.Y - top })
+	return clipPolygonEdge(path, func(p fyne.Position) float32 { return bottom - p.Y })
+}
+
+// clipPolygonEdge keeps the part of a closed path where distance is not
+// negative, adding points where the path crosses the edge
+func clipPolygonEdge(path []fyne.Position, distance func(fyne.Position) float32) []fyne.Position {
+	clipped := make([]fyne.Position, 0, len(path)+4)
+	for i, b := range path {
+		a := path[(i+len(path)-1)%len(path)]
+		da, db := distance(a), distance(b)
+		if (da >= 0) != (db >= 0) {
+			t := da / (da - db)
+			clipped = append(clipped, fyne.NewPos(a.X+t*(b.X-a.X), a.Y+t*(b.Y-a.Y)))
+		}
+		if db >= 0 {
+			clipped = append(clipped, b)
+		}
+	}
+	return clipped
+}
@@ -0,0 +1,98 @@
+package fynesimplechart
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"fyne.io/fyne/v2"
+)
+
+// polygonArea returns the area of a closed path by the shoelace formula
+func polygonArea(path []fyne.Position) float64 {
+	area := 0.0
+	for i, b := range path {
+		a := path[(i+len(path)-1)%len(path)]
+		area += float64(a.X*b.Y - b.X*a.Y)
+	}
+	return math.Abs(area) / 2
+}
+
+// Polygons are clipped to the plot area and filled with anti-aliased edges,
+// covering as many pixels as their clipped area
+func TestPolygonClipFill(t *testing.T) {
+	const left, top, right, bottom = 10, 10, 50, 50
+
+	tests := []struct {
+		name string
+		path []fyne.Position
+		area float64 // Area inside the plot area
+	}{
+		{
+			name: "inside",
+			path: []fyne.Position{fyne.NewPos(20, 20), fyne.NewPos(35, 20), fyne.NewPos(20, 35)},
+			area: 112.5,
+		},
+		{
+			name: "outside",
+			path: []fyne.Position{fyne.NewPos(60, 60), fyne.NewPos(70, 60), fyne.NewPos(70, 70), fyne.NewPos(60, 70)},
+			area: 0,
+		},
+		{
+			name: "across a corner",
+			path: []fyne.Position{fyne.NewPos(50, 40), fyne.NewPos(60, 50), fyne.NewPos(50, 60), fyne.NewPos(40, 50)},
+			area: 50,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clipped := clipPolygon(tt.path, left, top, right, bottom)
+			for _, p := range clipped {
+				if p.X < left || p.X > right || p.Y < top || p.Y > bottom {
+					t.Errorf("clipped point %v outside the plot area", p)
+				}
+			}
+			if area := polygonArea(clipped); math.Abs(area-tt.area) > 1e-3 {
+				t.Errorf("clipped area = %v, want %v", area, tt.area)
+			}
+
+			object := newPolygonObject()
+			object.draw(newPolygon([][]fyne.Position{clipped}, color.White))
+			origin, size := object.raster.Position(), object.raster.Size()
+			if tt.area == 0 {
+				if !size.IsZero() {
+					t.Errorf("raster size = %v, want nothing drawn", size)
+				}
+				return
+			}
+
+			img := object.raster.Generator(int(size.Width), int(size.Height)).(*image.RGBA)
+			coverage, partial := 0.0, 0
+			for py := 0; py < img.Rect.Dy(); py++ {
+				for px := 0; px < img.Rect.Dx(); px++ {
+					alpha := img.RGBAAt(px, py).A
+					if alpha == 0 {
+						continue
+					}
+					coverage += float64(alpha) / 255
+					if alpha < 255 {
+						partial++
+					}
+
+					x, y := origin.X+float32(px)+0.5, origin.Y+float32(py)+0.5
+					if x < left || x > right || y < top || y > bottom {
+						t.Errorf("pixel at (%v, %v) filled outside the plot area", x, y)
+					}
+				}
+			}
+			if math.Abs(coverage-tt.area) > 1 {
+				t.Errorf("filled %v pixels, want %v", coverage, tt.area)
+			}
+			if partial == 0 {
+				t.Error("no partly covered pixels along the slanted edges")
+			}
+		})
+	}
+}
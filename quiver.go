@@ -0,0 +1,213 @@
+package fynesimplechart
+
+import (
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+)
+
+// QuiverScaling defines how vector magnitudes map to arrow lengths
+type QuiverScaling int
+
+const (
+	QuiverAuto      QuiverScaling = iota // Default: lengths follow magnitude, the longest arrow is ArrowLength pixels
+	QuiverFixed                          // Every arrow is ArrowLength pixels; only direction is shown
+	QuiverMagnitude                      // Lengths are magnitude times Scale pixels, comparable across charts
+)
+
+// Quiver draws an arrow at each node pointing along (DX, DY). Directions are
+// drawn as given on screen, independent of the axis scales.
+type Quiver struct {
+	Nodes []Node // Arrow tails at (X, Y) with vectors (DX, DY)
+	Title string
+
+	Color       color.Color // nil uses auto-generated color
+	LineWidth   float32
+	HeadSize    float32       // Arrowhead length in pixels
+	Scaling     QuiverScaling // How magnitudes map to lengths
+	ArrowLength float32       // Pixels for the longest (auto) or every (fixed) arrow
+	Scale       float32       // Pixels per unit of magnitude for QuiverMagnitude
+
+	ColorByMagnitude bool       // Color arrows by their magnitude
+	ColorScale       ColorScale // Maps magnitudes to colors
+	ShowColorBar     bool       // Show a colour bar in the legend area
+
+	ShowKey      bool    // Show a reference arrow in the legend area
+	KeyMagnitude float32 // Magnitude of the reference arrow (0 = auto)
+}
+
+func NewQuiver(nodes []Node, title string) *Quiver {
+	quiver := &Quiver{
+		Nodes:            nodes,
+		Title:            title,
+		Color:            nil, // Will use auto-generated color if nil
+		LineWidth:        1.5,
+		HeadSize:         6,
+		Scaling:          QuiverAuto,
+		ArrowLength:      25,
+		Scale:            10,
+		ColorByMagnitude: false,
+		ColorScale:       NewSequentialScale(ColormapViridis),
+		ShowColorBar:     false,
+		ShowKey:          true,
+		KeyMagnitude:     0,
+	}
+
+	return quiver
+}
+
+// vectors returns the nodes whose position and vector are both known
+func (q Quiver) vectors() []Node {
+	vectors := make([]Node, 0, len(q.Nodes))
+	for _, n := range finiteNodes(q.Nodes) {
+		if isFinite(n.DX) && isFinite(n.DY) {
+			vectors = append(vectors, n)
+		}
+	}
+	return vectors
+}
+
+// magnitude returns the length of a node's vector
+func magnitude(n Node) float32 {
+	return float32(math.Hypot(float64(n.DX), float64(n.DY)))
+}
+
+// maxMagnitude returns the largest vector magnitude
+func (q Quiver) maxMagnitude() float32 {
+	maxMag := float32(0)
+	for _, n := range q.vectors() {
+		maxMag = float32(math.Max(float64(maxMag), float64(magnitude(n))))
+	}
+	return maxMag
+}
+
+// colorDomain returns the low and high ends of the magnitude colour scale
+func (q Quiver) colorDomain() (lo, hi float32, ok bool) {
+	vectors := q.vectors()
+	magnitudes := make([]float32, len(vectors))
+	for i, n := range vectors {
+		magnitudes[i] = magnitude(n)
+	}
+	return q.ColorScale.domain(magnitudes)
+}
+
+// arrowLength returns the screen length in pixels of an arrow of magnitude mag
+func (q Quiver) arrowLength(mag, maxMag float32) float32 {
+	switch q.Scaling {
+	case QuiverFixed:
+		if mag == 0 {
+			return 0
+		}
+		return q.ArrowLength
+	case QuiverMagnitude:
+		return mag * q.Scale
+	default:
+		if maxMag == 0 {
+			return 0
+		}
+		return mag / maxMag * q.ArrowLength
+	}
+}
+
+// keyMagnitude returns the magnitude of the reference arrow: the explicit
+// one, or the largest round number not above the longest vector
+func (q Quiver) keyMagnitude() float32 {
+	if q.KeyMagnitude > 0 {
+		return q.KeyMagnitude
+	}
+
+	maxMag := float64(q.maxMagnitude())
+	if maxMag == 0 {
+		return 0
+	}
+	step := math.Pow(10, math.Floor(math.Log10(maxMag)))
+	return float32(math.Floor(maxMag/step) * step)
+}
+
+// extendRange grows bounds to contain every arrow tail
+func (q Quiver) extendRange(bounds *dataRange) {
+	for _, n := range q.vectors() {
+		bounds.include(n.X, n.Y)
+	}
+}
+
+// Draw a quiver series
+func (r *scatterChartRenderer) drawQuiver(q Quiver, quiverColor color.Color, minX, maxX, minY, maxY, plotWidth, plotHeight, mLeft, mTop float32) {
+	vectors := q.vectors()
+	if len(vectors) == 0 {
+		return
+	}
+
+	// Transform functions from data coordinates to screen coordinates
+	transform := newDataTransform(minX, maxX, minY, maxY, plotWidth, plotHeight, mLeft, mTop)
+	dataToScreenX, dataToScreenY := transform.x, transform.y
+
+	maxMag := q.maxMagnitude()
+	colorLo, colorHi, colorOK := q.colorDomain()
+
+	for _, n := range vectors {
+		mag := magnitude(n)
+		arrowColor := quiverColor
+		if q.ColorByMagnitude && colorOK {
+			arrowColor = q.ColorScale.colorAt(mag, colorLo, colorHi)
+		}
+
+		tail := fyne.NewPos(dataToScreenX(n.X), dataToScreenY(n.Y))
+		r.drawQuiverArrow(q, tail, n.DX/mag, -n.DY/mag, q.arrowLength(mag, maxMag), arrowColor)
+	}
+}
+
+// Draw one arrow from tail along the unit screen direction (dirX, dirY)
+func (r *scatterChartRenderer) drawQuiverArrow(q Quiver, tail fyne.Position, dirX, dirY, length float32, arrowColor color.Color) {
+	if length <= 0 || !isFinite(dirX) || !isFinite(dirY) {
+		return
+	}
+
+	tip := fyne.NewPos(tail.X+dirX*length, tail.Y+dirY*length)
+
+	shaft := r.scene.newLine(arrowColor)
+	shaft.StrokeWidth = q.LineWidth
+	shaft.Position1 = tail
+	shaft.Position2 = tip
+	r.scene.add(shaft)
+
+	// Short arrows get proportionally smaller heads
+	headSize := float32(math.Min(float64(q.HeadSize), float64(length/2)))
+	r.drawArrowhead(tip, dirX, dirY, headSize, q.LineWidth, arrowColor)
+}
+
+// quiverKeyBlock returns a legend block with a reference arrow and its magnitude
+func (r *scatterChartRenderer) quiverKeyBlock(q Quiver, quiverColor color.Color) legendBlock {
+	keyMag := q.keyMagnitude()
+	length := q.arrowLength(keyMag, q.maxMagnitude())
+
+	return legendBlock{
+		width:  float32(math.Max(float64(length)+20, 90)),
+		height: 40,
+		draw: func(x, y float32) {
+			title := r.scene.newText(q.Title, r.scene.Foreground)
+			title.TextSize = 10
+			title.Move(fyne.NewPos(x+10, y))
+			r.scene.add(title)
+
+			r.drawQuiverArrow(q, fyne.NewPos(x+10, y+22), 1, 0, length, quiverColor)
+
+			label := r.scene.newText(formatAxisLabel(keyMag), r.scene.Foreground)
+			label.TextSize = 9
+			label.Move(fyne.NewPos(x+10, y+26))
+			r.scene.add(label)
+		},
+	}
+}
+
+// Draw a legend item for a quiver series
+func (r *scatterChartRenderer) drawQuiverLegendItem(q Quiver, quiverColor color.Color, x, y float32) {
+	r.drawQuiverArrow(q, fyne.NewPos(x+10, y+7), 1, 0, 15, quiverColor)
+
+	// Label
+	label := r.scene.newText(q.Title, r.scene.Foreground)
+	label.TextSize = 10
+	label.Move(fyne.NewPos(x+30, y))
+	r.scene.add(label)
+}
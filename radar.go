@@ -0,0 +1,389 @@
+package fynesimplechart
+
+import (
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/widget"
+)
+
+// RadarAxis is one spoke of a radar chart. When Max is not greater than Min
+// the range is taken from the series values on that axis.
+type RadarAxis struct {
+	Label string
+	Min   float32
+	Max   float32
+}
+
+type RadarSeries struct {
+	Values []float32 // One value per axis, in axis order
+	Title  string
+
+	Color      color.Color // nil uses auto-generated color
+	LineWidth  float32
+	ShowPoints bool
+	PointSize  float32
+
+	// Area fill properties
+	Fill      bool        // Fill the series polygon
+	FillColor color.Color // Color for fill (nil uses Color with transparency)
+}
+
+func NewRadarSeries(values []float32, title string) *RadarSeries {
+	series := &RadarSeries{
+		Values:     values,
+		Title:      title,
+		Color:      nil, // Will use auto-generated color if nil
+		LineWidth:  1.5,
+		ShowPoints: true,
+		PointSize:  3.0,
+		Fill:       true,
+		FillColor:  nil,
+	}
+
+	return series
+}
+
+type RadarChart struct {
+	widget.BaseWidget
+
+	Axes       []RadarAxis
+	Series     []RadarSeries
+	ChartTitle string
+	ShowGrid   bool
+	GridLevels int // Number of concentric grid polygons
+
+	// Legend properties
+	LegendPosition LegendPosition // Where to display the legend
+	ShowLegend     bool           // Whether to show legend
+
+	mTop    float32
+	mBottom float32
+	mLeft   float32
+	mRight  float32
+}
+
+// Constructor
+func NewRadarChart(axes []RadarAxis, series []RadarSeries) *RadarChart {
+	w := &RadarChart{
+		Axes:           axes,
+		Series:         series,
+		ChartTitle:     "",
+		ShowGrid:       true,
+		GridLevels:     5,
+		LegendPosition: LegendRight,
+		ShowLegend:     true,
+		mTop:           defaultMarginTop,
+		mBottom:        defaultMarginBottom,
+		mLeft:          defaultMarginLeft,
+		mRight:         defaultMarginRight,
+	}
+	w.ExtendBaseWidget(w)
+	return w
+}
+
+// SetChartTitle sets the main title for the chart
+func (c *RadarChart) SetChartTitle(title string) {
+	c.ChartTitle = title
+	c.Refresh()
+}
+
+// axisRange returns the value range of axis i, resolving automatic ranges
+// from zero to the largest value on that axis
+func (c *RadarChart) axisRange(i int) (lo, hi float32) {
+	axis := c.Axes[i]
+	if axis.Max > axis.Min {
+		return axis.Min, axis.Max
+	}
+
+	for _, s := range c.Series {
+		if i < len(s.Values) && isFinite(s.Values[i]) {
+			lo = float32(math.Min(float64(lo), float64(s.Values[i])))
+			hi = float32(math.Max(float64(hi), float64(s.Values[i])))
+		}
+	}
+	if hi == lo {
+		hi = lo + 1
+	}
+	return lo, hi
+}
+
+// Generates a new renderer for the RadarChart.
+func (c *RadarChart) CreateRenderer() fyne.WidgetRenderer {
+	c.ExtendBaseWidget(c)
+	r := &radarChartRenderer{widget: c}
+	r.sceneRenderer = sceneRenderer{owner: c, layout: r.layout}
+	return r
+}
+
+// Scene lays the chart out at the given size without drawing it, in the
+// colors of Fyne's dark theme. A nil measurer uses ApproximateTextSize, so
+// no running app is needed.
+func (c *RadarChart) Scene(size fyne.Size, measure TextMeasurer) *Scene {
+	r := &radarChartRenderer{widget: c}
+	r.scene = newScene(size, measure)
+	r.layout()
+	return r.scene
+}
+
+// Responsible for rendering the RadarChart.
+type radarChartRenderer struct {
+	sceneRenderer
+	widget *RadarChart
+}
+
+// Calculates the minimum size of the chart.
+func (r *radarChartRenderer) MinSize() fyne.Size {
+	return r.widget.Size()
+}
+
+// Lay the chart out into the scene
+func (r *radarChartRenderer) layout() {
+	numAxes := len(r.widget.Axes)
+	if numAxes < 3 {
+		return
+	}
+
+	mTop, mBottom, mLeft, mRight := r.widget.mTop, r.widget.mBottom, r.widget.mLeft, r.widget.mRight
+	widgetSize := r.scene.Size
+
+	areaWidth := widgetSize.Width - mLeft - mRight
+	areaHeight := widgetSize.Height - mTop - mBottom
+
+	// Leave room for the spoke labels
+	radius := float32(math.Min(float64(areaWidth), float64(areaHeight)))/2 - 20
+	if radius <= 0 {
+		return
+	}
+	center := fyne.NewPos(mLeft+areaWidth/2, mTop+areaHeight/2)
+
+	// Draw chart title if present
+	if r.widget.ChartTitle != "" {
+		titleText := r.scene.newText(r.widget.ChartTitle, r.scene.Foreground)
+		titleText.TextSize = 16
+		titleText.TextStyle.Bold = true
+		titleText.Alignment = fyne.TextAlignCenter
+		titleWidth := titleText.MinSize().Width
+		titleText.Move(fyne.NewPos((widgetSize.Width-titleWidth)/2, 10))
+		r.scene.addTo(layerLabels, titleText)
+	}
+
+	// Spokes run clockwise from 12 o'clock
+	spokeAngle := func(i int) float64 {
+		return 2 * math.Pi * float64(i) / float64(numAxes)
+	}
+	pointAt := func(i int, t float32) fyne.Position {
+		a := spokeAngle(i)
+		return fyne.NewPos(center.X+t*radius*float32(math.Sin(a)), center.Y-t*radius*float32(math.Cos(a)))
+	}
+
+	if r.widget.ShowGrid {
+		r.scene.setLayer(layerGrid)
+		r.drawGrid(numAxes, pointAt)
+	}
+
+	r.scene.setLayer(layerAxes)
+	r.drawSpokes(numAxes, center, radius, pointAt, spokeAngle)
+	r.scene.setLayer(layerSeries)
+
+	colors := generateColors(len(r.widget.Series))
+
+	// Draw fills first so every outline stays visible
+	for i, s := range r.widget.Series {
+		seriesColor := colors[i]
+		if s.Color != nil {
+			seriesColor = s.Color
+		}
+
+		if s.Fill {
+			fillColor := s.FillColor
+			if fillColor == nil {
+				fillColor = translucent(seriesColor)
+			}
+			r.scene.add(newPolygon([][]fyne.Position{r.seriesPoints(s, pointAt)}, fillColor))
+		}
+	}
+
+	for i, s := range r.widget.Series {
+		seriesColor := colors[i]
+		if s.Color != nil {
+			seriesColor = s.Color
+		}
+
+		r.drawSeries(s, seriesColor, pointAt)
+	}
+
+	// Draw legend
+	if r.widget.ShowLegend && r.widget.LegendPosition != LegendNone {
+		entries := []func(x, y float32){}
+		for i, s := range r.widget.Series {
+			s := s
+			seriesColor := colors[i]
+			if s.Color != nil {
+				seriesColor = s.Color
+			}
+
+			entries = append(entries, func(x, y float32) {
+				r.drawLegendItem(s, seriesColor, x, y)
+			})
+		}
+
+		drawLegendLayout(r.widget.LegendPosition, r.widget.ChartTitle != "", entries, nil, widgetSize.Width, widgetSize.Height, mTop, mRight, mBottom, r.scene)
+	}
+}
+
+// seriesPoints returns the vertices of a series polygon. Missing values sit
+// at the center.
+func (r *radarChartRenderer) seriesPoints(s RadarSeries, pointAt func(int, float32) fyne.Position) []fyne.Position {
+	points := make([]fyne.Position, len(r.widget.Axes))
+	for i := range r.widget.Axes {
+		t := float32(0)
+		if i < len(s.Values) && isFinite(s.Values[i]) {
+			lo, hi := r.widget.axisRange(i)
+			t = (s.Values[i] - lo) / (hi - lo)
+			t = float32(math.Max(0, math.Min(1, float64(t))))
+		}
+		points[i] = pointAt(i, t)
+	}
+	return points
+}
+
+// Draw concentric grid polygons
+func (r *radarChartRenderer) drawGrid(numAxes int, pointAt func(int, float32) fyne.Position) {
+	gridColor := color.RGBA{R: 128, G: 128, B: 128, A: 50}
+
+	levels := r.widget.GridLevels
+	if levels < 1 {
+		levels = 1
+	}
+
+	for level := 1; level <= levels; level++ {
+		t := float32(level) / float32(levels)
+		for i := 0; i < numAxes; i++ {
+			line := r.scene.newLine(gridColor)
+			line.StrokeWidth = gridLineWidth
+			line.Position1 = pointAt(i, t)
+			line.Position2 = pointAt((i+1)%numAxes, t)
+			r.scene.add(line)
+		}
+	}
+}
+
+// Draw the spokes with their labels outside the outer ring
+func (r *radarChartRenderer) drawSpokes(numAxes int, center fyne.Position, radius float32, pointAt func(int, float32) fyne.Position, spokeAngle func(int) float64) {
+	foregroundColor := r.scene.Foreground
+
+	for i, axis := range r.widget.Axes {
+		spoke := r.scene.newLine(foregroundColor)
+		spoke.StrokeWidth = gridLineWidth
+		spoke.Position1 = center
+		spoke.Position2 = pointAt(i, 1)
+		r.scene.add(spoke)
+
+		label := r.scene.newText(axis.Label, foregroundColor)
+		label.TextSize = 10
+		labelWidth := label.MinSize().Width
+		labelHeight := label.MinSize().Height
+
+		a := spokeAngle(i)
+		anchor := pointAt(i, 1+8/radius)
+
+		// Grow the label away from the chart on either side
+		labelX := anchor.X
+		if math.Sin(a) < -0.1 {
+			labelX -= labelWidth
+		} else if math.Sin(a) <= 0.1 {
+			labelX -= labelWidth / 2
+		}
+		labelY := anchor.Y - labelHeight/2
+		if math.Cos(a) > 0.9 {
+			labelY -= labelHeight / 2
+		} else if math.Cos(a) < -0.9 {
+			labelY += labelHeight / 2
+		}
+
+		label.Move(fyne.NewPos(labelX, labelY))
+		r.scene.addTo(layerLabels, label)
+	}
+
+	r.drawLevelLabels(pointAt)
+}
+
+// Label the grid levels along the first spoke when every axis shares a range
+func (r *radarChartRenderer) drawLevelLabels(pointAt func(int, float32) fyne.Position) {
+	lo, hi := r.widget.axisRange(0)
+	for i := range r.widget.Axes {
+		axisLo, axisHi := r.widget.axisRange(i)
+		if axisLo != lo || axisHi != hi {
+			return
+		}
+	}
+
+	levels := r.widget.GridLevels
+	if levels < 1 {
+		levels = 1
+	}
+
+	for level := 1; level <= levels; level++ {
+		t := float32(level) / float32(levels)
+		label := r.scene.newText(formatAxisLabel(lo+t*(hi-lo)), r.scene.Foreground)
+		label.TextSize = 9
+		pos := pointAt(0, t)
+		label.Move(fyne.NewPos(pos.X+3, pos.Y-label.MinSize().Height))
+		r.scene.addTo(layerLabels, label)
+	}
+}
+
+// Draw the outline and points of a series
+func (r *radarChartRenderer) drawSeries(s RadarSeries, seriesColor color.Color, pointAt func(int, float32) fyne.Position) {
+	points := r.seriesPoints(s, pointAt)
+
+	for i := range points {
+		line := r.scene.newLine(seriesColor)
+		line.StrokeWidth = s.LineWidth
+		line.Position1 = points[i]
+		line.Position2 = points[(i+1)%len(points)]
+		r.scene.add(line)
+	}
+
+	if s.ShowPoints {
+		for _, p := range points {
+			circle := r.scene.newCircle(seriesColor)
+			circle.StrokeColor = seriesColor
+			circle.StrokeWidth = 1
+			circle.Resize(fyne.NewSize(s.PointSize*2, s.PointSize*2))
+			circle.Move(fyne.NewPos(p.X-s.PointSize, p.Y-s.PointSize))
+			r.scene.add(circle)
+		}
+	}
+}
+
+// Draw a single legend item
+func (r *radarChartRenderer) drawLegendItem(s RadarSeries, seriesColor color.Color, x, y float32) {
+	if s.Fill {
+		fillColor := s.FillColor
+		if fillColor == nil {
+			fillColor = translucent(seriesColor)
+		}
+
+		rect := r.scene.newRectangle(fillColor)
+		rect.StrokeColor = seriesColor
+		rect.StrokeWidth = 1
+		rect.Resize(fyne.NewSize(12, 12))
+		rect.Move(fyne.NewPos(x+10, y+2))
+		r.scene.add(rect)
+	} else {
+		line := r.scene.newLine(seriesColor)
+		line.StrokeWidth = s.LineWidth
+		line.Position1 = fyne.NewPos(x+10, y+5)
+		line.Position2 = fyne.NewPos(x+25, y+5)
+		r.scene.add(line)
+	}
+
+	// Label
+	label := r.scene.newText(s.Title, r.scene.Foreground)
+	label.TextSize = 10
+	label.Move(fyne.NewPos(x+30, y))
+	r.scene.add(label)
+}
@@ -0,0 +1,222 @@
+package fynesimplechart
+
+import (
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+)
+
+// ReferenceAxis defines which axis a reference line or band is placed on
+type ReferenceAxis int
+
+const (
+	ReferenceY ReferenceAxis = iota // Default: horizontal, at Y values
+	ReferenceX                      // Vertical, at X values
+)
+
+// ReferenceLine marks a single value across the whole plot area, such as a
+// threshold. It has no legend entry.
+type ReferenceLine struct {
+	Axis  ReferenceAxis
+	Value float32
+	Label string
+
+	Color       color.Color // nil uses theme foreground
+	LineWidth   float32
+	LineStyle   LineStyle
+	DashPattern []float32 // On/off lengths in pixels for LineCustom
+
+	IncludeInRange bool // Widen the automatic range so the line is visible
+}
+
+func NewReferenceLine(axis ReferenceAxis, value float32, label string) *ReferenceLine {
+	line := &ReferenceLine{
+		Axis:           axis,
+		Value:          value,
+		Label:          label,
+		Color:          nil, // Will use theme foreground if nil
+		LineWidth:      1,
+		LineStyle:      LineDashed,
+		DashPattern:    nil,
+		IncludeInRange: true,
+	}
+
+	return line
+}
+
+// ReferenceBand shades the range between two values across the whole plot
+// area, such as a target range. It has no legend entry.
+type ReferenceBand struct {
+	Axis  ReferenceAxis
+	From  float32
+	To    float32
+	Label string
+
+	Color       color.Color // Fill (nil uses theme foreground with transparency)
+	LineColor   color.Color // Edge lines (nil uses theme foreground)
+	LineWidth   float32     // Edge line width (0 = no edges)
+	LineStyle   LineStyle
+	DashPattern []float32 // On/off lengths in pixels for LineCustom
+
+	IncludeInRange bool // Widen the automatic range so the band is visible
+}
+
+func NewReferenceBand(axis ReferenceAxis, from, to float32, label string) *ReferenceBand {
+	band := &ReferenceBand{
+		Axis:           axis,
+		From:           from,
+		To:             to,
+		Label:          label,
+		Color:          nil, // Will use theme foreground with transparency if nil
+		LineColor:      nil,
+		LineWidth:      0,
+		LineStyle:      LineSolid,
+		DashPattern:    nil,
+		IncludeInRange: true,
+	}
+
+	return band
+}
+
+// includeReference grows a range that already holds data to contain value
+// on the given axis
+func (d *dataRange) includeReference(axis ReferenceAxis, value float32) {
+	if !d.ok || !isFinite(value) {
+		return
+	}
+	if axis == ReferenceX {
+		d.include(value, d.minY)
+	} else {
+		d.include(d.minX, value)
+	}
+}
+
+// extendRange grows bounds to contain the line if it counts toward the range
+func (l ReferenceLine) extendRange(bounds *dataRange) {
+	if l.IncludeInRange {
+		bounds.includeReference(l.Axis, l.Value)
+	}
+}
+
+// extendRange grows bounds to contain the band if it counts toward the range
+func (b ReferenceBand) extendRange(bounds *dataRange) {
+	if b.IncludeInRange {
+		bounds.includeReference(b.Axis, b.From)
+		bounds.includeReference(b.Axis, b.To)
+	}
+}
+
+// Draw a reference band, clipped to the plot area
+func (r *scatterChartRenderer) drawReferenceBand(band ReferenceBand, minX, maxX, minY, maxY, plotWidth, plotHeight, mLeft, mTop float32) {
+	lo := float32(math.Min(float64(band.From), float64(band.To)))
+	hi := float32(math.Max(float64(band.From), float64(band.To)))
+	if !isFinite(lo) || !isFinite(hi) {
+		return
+	}
+
+	// Band extent in screen coordinates
+	transform := newDataTransform(minX, maxX, minY, maxY, plotWidth, plotHeight, mLeft, mTop)
+	var x1, y1, x2, y2 float32
+	if band.Axis == ReferenceX {
+		if hi < minX || lo > maxX {
+			return
+		}
+		x1 = transform.x(float32(math.Max(float64(lo), float64(minX))))
+		x2 = transform.x(float32(math.Min(float64(hi), float64(maxX))))
+		y1, y2 = mTop, mTop+plotHeight
+	} else {
+		if hi < minY || lo > maxY {
+			return
+		}
+		y1 = transform.y(float32(math.Min(float64(hi), float64(maxY))))
+		y2 = transform.y(float32(math.Max(float64(lo), float64(minY))))
+		x1, x2 = mLeft, mLeft+plotWidth
+	}
+
+	fillColor := band.Color
+	if fillColor == nil {
+		fillColor = translucent(r.scene.Foreground)
+	}
+	rect := r.scene.newRectangle(fillColor)
+	rect.Move(fyne.NewPos(x1, y1))
+	rect.Resize(fyne.NewSize(x2-x1, y2-y1))
+	r.scene.add(rect)
+
+	// Edges, only where the band ends inside the plot area
+	if band.LineWidth > 0 {
+		lineColor := band.LineColor
+		if lineColor == nil {
+			lineColor = r.scene.Foreground
+		}
+		pattern := dashPattern(band.LineStyle, band.DashPattern, band.LineWidth)
+
+		if band.Axis == ReferenceX {
+			if lo >= minX {
+				r.drawPolyline([]fyne.Position{fyne.NewPos(x1, y1), fyne.NewPos(x1, y2)}, lineColor, band.LineWidth, pattern)
+			}
+			if hi <= maxX {
+				r.drawPolyline([]fyne.Position{fyne.NewPos(x2, y1), fyne.NewPos(x2, y2)}, lineColor, band.LineWidth, pattern)
+			}
+		} else {
+			if hi <= maxY {
+				r.drawPolyline([]fyne.Position{fyne.NewPos(x1, y1), fyne.NewPos(x2, y1)}, lineColor, band.LineWidth, pattern)
+			}
+			if lo >= minY {
+				r.drawPolyline([]fyne.Position{fyne.NewPos(x1, y2), fyne.NewPos(x2, y2)}, lineColor, band.LineWidth, pattern)
+			}
+		}
+	}
+
+	// Label in the top left corner of the band
+	if band.Label != "" {
+		label := r.scene.newText(band.Label, r.scene.Foreground)
+		label.TextSize = 10
+		label.Move(fyne.NewPos(x1+4, y1+2))
+		r.scene.add(label)
+	}
+}
+
+// Draw a reference line across the plot area, if its value is visible
+func (r *scatterChartRenderer) drawReferenceLine(line ReferenceLine, minX, maxX, minY, maxY, plotWidth, plotHeight, mLeft, mTop float32) {
+	lineColor := line.Color
+	if lineColor == nil {
+		lineColor = r.scene.Foreground
+	}
+	pattern := dashPattern(line.LineStyle, line.DashPattern, line.LineWidth)
+	transform := newDataTransform(minX, maxX, minY, maxY, plotWidth, plotHeight, mLeft, mTop)
+
+	var label *SceneText
+	if line.Label != "" {
+		label = r.scene.newText(line.Label, lineColor)
+		label.TextSize = 10
+	}
+
+	if line.Axis == ReferenceX {
+		if !isFinite(line.Value) || line.Value < minX || line.Value > maxX {
+			return
+		}
+		x := transform.x(line.Value)
+		r.drawPolyline([]fyne.Position{fyne.NewPos(x, mTop), fyne.NewPos(x, mTop+plotHeight)}, lineColor, line.LineWidth, pattern)
+
+		// Label at the top, right of the line
+		if label != nil {
+			label.Move(fyne.NewPos(x+4, mTop+2))
+			r.scene.add(label)
+		}
+		return
+	}
+
+	if !isFinite(line.Value) || line.Value < minY || line.Value > maxY {
+		return
+	}
+	y := transform.y(line.Value)
+	r.drawPolyline([]fyne.Position{fyne.NewPos(mLeft, y), fyne.NewPos(mLeft+plotWidth, y)}, lineColor, line.LineWidth, pattern)
+
+	// Label at the right end, above the line
+	if label != nil {
+		labelSize := label.MinSize()
+		label.Move(fyne.NewPos(mLeft+plotWidth-labelSize.Width-4, y-labelSize.Height))
+		r.scene.add(label)
+	}
+}
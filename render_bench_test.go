@@ -0,0 +1,75 @@
+package fynesimplechart_test
+
+import (
+	"math"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/alexiusacademia/fynesimplechart"
+)
+
+// benchChart returns a line chart of a few thousand points with a grid,
+// titles and a legend
+func benchChart() *fynesimplechart.ScatterPlot {
+	nodes := make([]fynesimplechart.Node, 3000)
+	for i := range nodes {
+		nodes[i] = fynesimplechart.Node{X: float32(i), Y: float32(100 + 50*math.Sin(float64(i)/40))}
+	}
+	plot := fynesimplechart.NewPlot(nodes, "signal")
+	plot.ShowLine = true
+
+	chart := fynesimplechart.NewGraphWidget([]fynesimplechart.Plot{*plot})
+	chart.ChartTitle = "Benchmark"
+	chart.XAxisTitle = "sample"
+	chart.YAxisTitle = "value"
+	return chart
+}
+
+// Every layout on a new renderer creates every canvas object, as a renderer
+// without pooling would
+func BenchmarkLayoutNewRenderer(b *testing.B) {
+	test.NewApp()
+	chart := benchChart()
+	chart.Resize(fyne.NewSize(800, 600))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		chart.CreateRenderer().Layout(chart.Size())
+	}
+}
+
+// Resizing a shown chart updates its pooled objects in place
+func BenchmarkLayoutResize(b *testing.B) {
+	test.NewApp()
+	chart := benchChart()
+	chart.Resize(fyne.NewSize(800, 600))
+	renderer := test.WidgetRenderer(chart)
+	renderer.Layout(chart.Size())
+
+	sizes := []fyne.Size{fyne.NewSize(800, 600), fyne.NewSize(801, 601)}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// Resize lays the renderer out
+		chart.Resize(sizes[i%2])
+	}
+}
+
+// Refreshing after a data change only updates the series objects
+func BenchmarkRefreshData(b *testing.B) {
+	test.NewApp()
+	chart := benchChart()
+	chart.Resize(fyne.NewSize(800, 600))
+	renderer := test.WidgetRenderer(chart)
+	renderer.Layout(chart.Size())
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		chart.Plots[0].Nodes[i%len(chart.Plots[0].Nodes)].Y += 1
+		renderer.Refresh()
+	}
+}
@@ -0,0 +1,288 @@
+package fynesimplechart
+
+import (
+	"image"
+	"image/color"
+	"unicode/utf8"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+)
+
+// Scene is a chart laid out as drawing primitives, in paint order, with
+// positions in pixels from the top left corner of the chart. Building a
+// scene needs no running app or canvas; the widget renderers draw their
+// scene with Fyne canvas objects and an exporter can draw it with anything.
+type Scene struct {
+	Size       fyne.Size
+	Foreground color.Color // Theme color for text, axes and outlines
+	Background color.Color // Theme color behind the chart
+	Primitives []Primitive
+
+	measure TextMeasurer
+	layer   sceneLayer   // Layer of primitives added next
+	layers  []sceneLayer // Layer of each primitive
+
+	// Primitives of the previous layout, reused by the next one
+	spareLines   []*SceneLine
+	spareRects   []*SceneRect
+	spareCircles []*SceneCircle
+	spareTexts   []*SceneText
+}
+
+// sceneLayer groups primitives that usually change together. Renderers keep
+// separate canvas objects for each layer, so a change in one layer does not
+// disturb the objects of the others.
+type sceneLayer int
+
+const (
+	layerSeries sceneLayer = iota // Default: data and everything drawn with it
+	layerGrid                     // Grid lines
+	layerAxes                     // Axis lines, ticks and border
+	layerLabels                   // Tick labels and titles
+	layerLegend                   // Legend entries and colour bars
+	layerCount
+)
+
+// TextMeasurer returns the size of text when drawn. Layout uses it to place
+// labels; fyne.MeasureText is the measurer for on-screen charts, and needs a
+// running app.
+type TextMeasurer func(text string, size float32, style fyne.TextStyle) fyne.Size
+
+// ApproximateTextSize measures text as if every character were 0.55 em
+// wide (0.58 em when bold), close to Fyne's default font. Unlike
+// fyne.MeasureText it needs no running app.
+func ApproximateTextSize(text string, size float32, style fyne.TextStyle) fyne.Size {
+	advance := float32(0.55)
+	if style.Bold {
+		advance = 0.58
+	}
+	return fyne.NewSize(float32(utf8.RuneCountInString(text))*size*advance, size*1.3625)
+}
+
+// Primitive is one element of a scene: a *SceneLine, *SceneRect,
+// *SceneCircle, *SceneText, *ScenePolygon or *SceneRaster
+type Primitive interface {
+	primitive()
+}
+
+// SceneLine is a straight line between two points
+type SceneLine struct {
+	Position1   fyne.Position
+	Position2   fyne.Position
+	StrokeColor color.Color
+	StrokeWidth float32
+}
+
+// SceneRect is an axis-aligned rectangle
+type SceneRect struct {
+	Position    fyne.Position
+	Size        fyne.Size
+	FillColor   color.Color
+	StrokeColor color.Color
+	StrokeWidth float32
+}
+
+// SceneCircle is a circle or ellipse filling the box at Position with Size
+type SceneCircle struct {
+	Position    fyne.Position
+	Size        fyne.Size
+	FillColor   color.Color
+	StrokeColor color.Color
+	StrokeWidth float32
+}
+
+// SceneText is a single line of text with its top left corner at Position
+type SceneText struct {
+	Position  fyne.Position
+	Text      string
+	Color     color.Color
+	TextSize  float32
+	TextStyle fyne.TextStyle
+	Alignment fyne.TextAlign
+
+	measure TextMeasurer
+}
+
+// ScenePolygon fills closed paths. Paths wound in opposite directions
+// cancel out, so a ring is an outer path plus a reversed inner path.
+type ScenePolygon struct {
+	Paths     [][]fyne.Position
+	FillColor color.Color
+}
+
+// SceneRaster is an image generated at the output resolution, w×h pixels,
+// and stretched over the box at Position with Size
+type SceneRaster struct {
+	Position  fyne.Position
+	Size      fyne.Size
+	Generate  func(w, h int) image.Image
+	Pixelated bool // Scale without smoothing, for cell-based images
+}
+
+func (*SceneLine) primitive()    {}
+func (*SceneRect) primitive()    {}
+func (*SceneCircle) primitive()  {}
+func (*SceneText) primitive()    {}
+func (*ScenePolygon) primitive() {}
+func (*SceneRaster) primitive()  {}
+
+// newScene returns an empty scene of the given size with the colors of
+// Fyne's dark theme, which need no running app. A nil measurer uses
+// ApproximateTextSize.
+func newScene(size fyne.Size, measure TextMeasurer) *Scene {
+	if measure == nil {
+		measure = ApproximateTextSize
+	}
+
+	return &Scene{
+		Size:       size,
+		Foreground: color.NRGBA{R: 0xf3, G: 0xf3, B: 0xf3, A: 0xff},
+		Background: color.NRGBA{R: 0x17, G: 0x17, B: 0x18, A: 0xff},
+		measure:    measure,
+	}
+}
+
+// themeScene empties a scene for a new layout in the current app's theme,
+// for drawing on screen. A nil scene is replaced by a new one.
+func themeScene(scene *Scene, size fyne.Size) *Scene {
+	if scene == nil {
+		scene = newScene(size, fyne.MeasureText)
+	} else {
+		scene.reset(size)
+	}
+	scene.Foreground = theme.ForegroundColor()
+	scene.Background = theme.BackgroundColor()
+	return scene
+}
+
+// reset empties the scene for a new layout at the given size, keeping its
+// lines, rectangles, circles and text to be reused by the new layout
+func (s *Scene) reset(size fyne.Size) {
+	for _, primitive := range s.Primitives {
+		switch p := primitive.(type) {
+		case *SceneLine:
+			s.spareLines = append(s.spareLines, p)
+		case *SceneRect:
+			s.spareRects = append(s.spareRects, p)
+		case *SceneCircle:
+			s.spareCircles = append(s.spareCircles, p)
+		case *SceneText:
+			s.spareTexts = append(s.spareTexts, p)
+		}
+	}
+
+	s.Size = size
+	s.Primitives = s.Primitives[:0]
+	s.layers = s.layers[:0]
+	s.layer = layerSeries
+}
+
+// add appends a primitive on top of the scene, in the current layer
+func (s *Scene) add(p Primitive) {
+	s.addTo(s.layer, p)
+}
+
+// addTo appends a primitive on top of the scene, in the given layer
+func (s *Scene) addTo(layer sceneLayer, p Primitive) {
+	s.Primitives = append(s.Primitives, p)
+	s.layers = append(s.layers, layer)
+}
+
+// setLayer sets the layer of the primitives added next
+func (s *Scene) setLayer(layer sceneLayer) {
+	s.layer = layer
+}
+
+// newLine returns a line of the given color, to be placed and added
+func (s *Scene) newLine(stroke color.Color) *SceneLine {
+	var line *SceneLine
+	if n := len(s.spareLines); n > 0 {
+		line, s.spareLines = s.spareLines[n-1], s.spareLines[:n-1]
+	} else {
+		line = &SceneLine{}
+	}
+	*line = SceneLine{StrokeColor: stroke, StrokeWidth: 1}
+	return line
+}
+
+// newRectangle returns a rectangle of the given fill, to be placed and added
+func (s *Scene) newRectangle(fill color.Color) *SceneRect {
+	var rect *SceneRect
+	if n := len(s.spareRects); n > 0 {
+		rect, s.spareRects = s.spareRects[n-1], s.spareRects[:n-1]
+	} else {
+		rect = &SceneRect{}
+	}
+	*rect = SceneRect{FillColor: fill}
+	return rect
+}
+
+// newCircle returns a circle of the given fill, to be placed and added
+func (s *Scene) newCircle(fill color.Color) *SceneCircle {
+	var circle *SceneCircle
+	if n := len(s.spareCircles); n > 0 {
+		circle, s.spareCircles = s.spareCircles[n-1], s.spareCircles[:n-1]
+	} else {
+		circle = &SceneCircle{}
+	}
+	*circle = SceneCircle{FillColor: fill}
+	return circle
+}
+
+// newText returns text measured by the scene's measurer, to be placed and added
+func (s *Scene) newText(text string, textColor color.Color) *SceneText {
+	var label *SceneText
+	if n := len(s.spareTexts); n > 0 {
+		label, s.spareTexts = s.spareTexts[n-1], s.spareTexts[:n-1]
+	} else {
+		label = &SceneText{}
+	}
+	*label = SceneText{Text: text, Color: textColor, TextSize: 14, measure: s.measure}
+	return label
+}
+
+// newRaster returns a raster drawn by generate, to be placed and added
+func (s *Scene) newRaster(generate func(w, h int) image.Image) *SceneRaster {
+	return &SceneRaster{Generate: generate}
+}
+
+// Move places the rectangle's top left corner
+func (r *SceneRect) Move(pos fyne.Position) {
+	r.Position = pos
+}
+
+// Resize sets the rectangle's size
+func (r *SceneRect) Resize(size fyne.Size) {
+	r.Size = size
+}
+
+// Move places the top left corner of the circle's box
+func (c *SceneCircle) Move(pos fyne.Position) {
+	c.Position = pos
+}
+
+// Resize sets the size of the circle's box
+func (c *SceneCircle) Resize(size fyne.Size) {
+	c.Size = size
+}
+
+// Move places the text's top left corner
+func (t *SceneText) Move(pos fyne.Position) {
+	t.Position = pos
+}
+
+// MinSize returns the size of the text when drawn
+func (t *SceneText) MinSize() fyne.Size {
+	return t.measure(t.Text, t.TextSize, t.TextStyle)
+}
+
+// Move places the raster's top left corner
+func (r *SceneRaster) Move(pos fyne.Position) {
+	r.Position = pos
+}
+
+// Resize sets the raster's size
+func (r *SceneRaster) Resize(size fyne.Size) {
+	r.Size = size
+}
@@ -0,0 +1,275 @@
+package fynesimplechart
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+)
+
+// canvasPool draws scenes with Fyne canvas objects, keeping the objects of
+// every layer between renders and updating them in place. Only objects that
+// are new or whose properties changed need to be refreshed. The scene itself
+// is still laid out in full on every render.
+type canvasPool struct {
+	layers  [layerCount]layerPool
+	objects []fyne.CanvasObject // Objects of the last draw, in paint order
+	changed []fyne.CanvasObject // Objects changed by the last draw
+}
+
+// layerPool holds the canvas objects of one layer, by kind, in the order
+// they were last used
+type layerPool struct {
+	lines    []*canvas.Line
+	rects    []*canvas.Rectangle
+	circles  []*canvas.Circle
+	texts    []*canvas.Text
+	rasters  []*canvas.Raster
+	polygons []*polygonObject
+
+	// Number of objects of each kind used by the current draw
+	nLines, nRects, nCircles, nTexts, nRasters, nPolygons int
+}
+
+// draw returns the canvas objects for a scene in paint order, reusing the
+// objects of the previous draw where it can. The n-th primitive of a kind in
+// a layer is drawn with the n-th object of that kind the layer drew before.
+// The returned list is only valid until the next draw.
+func (pool *canvasPool) draw(s *Scene) []fyne.CanvasObject {
+	for i := range pool.layers {
+		pool.layers[i].reset()
+	}
+	pool.changed = pool.changed[:0]
+
+	objects := pool.objects[:0]
+	for i, primitive := range s.Primitives {
+		object, changed := pool.layers[s.layers[i]].draw(primitive)
+		if object == nil {
+			continue
+		}
+		if changed {
+			pool.changed = append(pool.changed, object)
+		}
+		objects = append(objects, object)
+	}
+
+	pool.objects = objects
+	return objects
+}
+
+// reset starts a new draw of the layer
+func (l *layerPool) reset() {
+	l.nLines, l.nRects, l.nCircles, l.nTexts, l.nRasters, l.nPolygons = 0, 0, 0, 0, 0, 0
+}
+
+// draw updates the next unused object of the primitive's kind, creating it
+// if the layer has none left, and reports whether the object changed
+func (l *layerPool) draw(primitive Primitive) (fyne.CanvasObject, bool) {
+	switch p := primitive.(type) {
+	case *SceneLine:
+		if l.nLines == len(l.lines) {
+			l.lines = append(l.lines, &canvas.Line{})
+		}
+		line := l.lines[l.nLines]
+		l.nLines++
+
+		changed := line.Position1 != p.Position1 || line.Position2 != p.Position2 ||
+			line.StrokeWidth != p.StrokeWidth || !sameColor(line.StrokeColor, p.StrokeColor)
+		line.StrokeColor = p.StrokeColor
+		line.StrokeWidth = p.StrokeWidth
+		line.Position1 = p.Position1
+		line.Position2 = p.Position2
+		return line, changed
+
+	case *SceneRect:
+		if l.nRects == len(l.rects) {
+			l.rects = append(l.rects, &canvas.Rectangle{})
+		}
+		rect := l.rects[l.nRects]
+		l.nRects++
+
+		changed := rect.Position() != p.Position || rect.Size() != p.Size || rect.StrokeWidth != p.StrokeWidth ||
+			!sameColor(rect.FillColor, p.FillColor) || !sameColor(rect.StrokeColor, p.StrokeColor)
+		rect.FillColor = p.FillColor
+		rect.StrokeColor = p.StrokeColor
+		rect.StrokeWidth = p.StrokeWidth
+		rect.Move(p.Position)
+		rect.Resize(p.Size)
+		return rect, changed
+
+	case *SceneCircle:
+		if l.nCircles == len(l.circles) {
+			l.circles = append(l.circles, &canvas.Circle{})
+		}
+		circle := l.circles[l.nCircles]
+		l.nCircles++
+
+		changed := circle.Position() != p.Position || circle.Size() != p.Size || circle.StrokeWidth != p.StrokeWidth ||
+			!sameColor(circle.FillColor, p.FillColor) || !sameColor(circle.StrokeColor, p.StrokeColor)
+		circle.FillColor = p.FillColor
+		circle.StrokeColor = p.StrokeColor
+		circle.StrokeWidth = p.StrokeWidth
+		circle.Move(p.Position)
+		circle.Resize(p.Size)
+		return circle, changed
+
+	case *SceneText:
+		if l.nTexts == len(l.texts) {
+			l.texts = append(l.texts, &canvas.Text{})
+		}
+		text := l.texts[l.nTexts]
+		l.nTexts++
+
+		changed := text.Position() != p.Position || text.Text != p.Text || text.TextSize != p.TextSize ||
+			text.TextStyle != p.TextStyle || text.Alignment != p.Alignment || !sameColor(text.Color, p.Color)
+		text.Text = p.Text
+		text.Color = p.Color
+		text.TextSize = p.TextSize
+		text.TextStyle = p.TextStyle
+		text.Alignment = p.Alignment
+		text.Move(p.Position)
+		return text, changed
+
+	case *ScenePolygon:
+		if l.nPolygons == len(l.polygons) {
+			l.polygons = append(l.polygons, newPolygonObject())
+		}
+		polygon := l.polygons[l.nPolygons]
+		l.nPolygons++
+
+		return polygon.raster, polygon.draw(p)
+
+	case *SceneRaster:
+		if l.nRasters == len(l.rasters) {
+			l.rasters = append(l.rasters, &canvas.Raster{})
+		}
+		raster := l.rasters[l.nRasters]
+		l.nRasters++
+
+		// Generators cannot be compared, so rasters always redraw
+		raster.Generator = p.Generate
+		raster.ScaleMode = canvas.ImageScaleSmooth
+		if p.Pixelated {
+			raster.ScaleMode = canvas.ImageScalePixels
+		}
+		raster.Move(p.Position)
+		raster.Resize(p.Size)
+		return raster, true
+	}
+
+	return nil, false
+}
+
+// refresh asks the canvas to redraw the objects changed by the last draw
+func (pool *canvasPool) refresh() {
+	for _, object := range pool.changed {
+		canvas.Refresh(object)
+	}
+}
+
+// sameColor reports whether two colors are equal, treating nil as no color
+func sameColor(a, b color.Color) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	r1, g1, b1, a1 := a.RGBA()
+	r2, g2, b2, a2 := b.RGBA()
+	return r1 == r2 && g1 == g2 && b1 == b2 && a1 == a2
+}
+
+// sceneRenderer is the part of a widget renderer that lays the widget out
+// into a scene and draws the scene with pooled canvas objects
+type sceneRenderer struct {
+	owner    fyne.Widget
+	layout   func()                                      // Lays the widget out into scene
+	overlays func() (objects, moved []fyne.CanvasObject) // Optional, objects placed on top of the scene and those that moved
+
+	scene   *Scene
+	pool    canvasPool
+	objects []fyne.CanvasObject // Scene objects then overlays, in paint order
+	moved   []fyne.CanvasObject // Overlays moved or resized by the last render
+	rebuilt bool                // Whether the last render added, dropped or reordered objects
+}
+
+// Layout the components.
+func (r *sceneRenderer) Layout(size fyne.Size) {
+	r.render()
+	r.refresh()
+}
+
+// Called when the theme changes.
+func (r *sceneRenderer) ApplyTheme() {
+	r.render()
+	r.refresh()
+}
+
+// Updates the widget's rendering, redrawing only the objects that changed.
+func (r *sceneRenderer) Refresh() {
+	r.render()
+	if r.rebuilt {
+		// Dropped objects are not in the changed list
+		canvas.Refresh(r.owner)
+		return
+	}
+	r.refresh()
+}
+
+// Returns the background color of the widget.
+func (r *sceneRenderer) BackgroundColor() color.Color {
+	return theme.BackgroundColor()
+}
+
+// Return the objects contained in the widget.
+func (r *sceneRenderer) Objects() []fyne.CanvasObject {
+	return r.objects
+}
+
+// Called when the widget is destroyed.
+func (r *sceneRenderer) Destroy() {
+}
+
+// Main render function
+func (r *sceneRenderer) render() {
+	r.scene = themeScene(r.scene, r.owner.Size())
+	r.layout()
+	objects := r.pool.draw(r.scene)
+	var overlays []fyne.CanvasObject
+	r.moved = nil
+	if r.overlays != nil {
+		overlays, r.moved = r.overlays()
+	}
+
+	// Keep the list while it holds the same objects, as the canvas may still
+	// be walking it; a changed list is built anew
+	r.rebuilt = !sameObjects(r.objects, objects, overlays)
+	if r.rebuilt {
+		r.objects = append(append(make([]fyne.CanvasObject, 0, len(objects)+len(overlays)), objects...), overlays...)
+	}
+}
+
+// refresh asks the canvas to redraw the objects changed by the last render
+func (r *sceneRenderer) refresh() {
+	r.pool.refresh()
+	for _, object := range r.moved {
+		canvas.Refresh(object)
+	}
+}
+
+// sameObjects reports whether list holds the objects of first then second
+func sameObjects(list, first, second []fyne.CanvasObject) bool {
+	if len(list) != len(first)+len(second) {
+		return false
+	}
+	for i, object := range first {
+		if list[i] != object {
+			return false
+		}
+	}
+	for i, object := range second {
+		if list[len(first)+i] != object {
+			return false
+		}
+	}
+	return true
+}
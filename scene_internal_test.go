@@ -0,0 +1,167 @@
+package fynesimplechart
+
+import (
+	"reflect"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+)
+
+// sceneContents returns the number of primitives and the texts in each layer
+func sceneContents(s *Scene) ([layerCount]int, [layerCount][]string) {
+	var counts [layerCount]int
+	var texts [layerCount][]string
+	for i, primitive := range s.Primitives {
+		counts[s.layers[i]]++
+		if text, ok := primitive.(*SceneText); ok {
+			texts[s.layers[i]] = append(texts[s.layers[i]], text.Text)
+		}
+	}
+	return counts, texts
+}
+
+func TestScenePrimitives(t *testing.T) {
+	tests := []struct {
+		name   string
+		scene  func() *Scene
+		counts [layerCount]int
+		labels []string // Tick labels and titles
+		legend []string
+	}{
+		{
+			name: "scatter",
+			scene: func() *Scene {
+				line := NewPlot([]Node{{X: 0, Y: 0}, {X: 5, Y: 5}, {X: 10, Y: 10}}, "line")
+				line.ShowLine = true
+				points := NewPlot([]Node{{X: 0, Y: 10}, {X: 10, Y: 0}}, "points")
+				chart := NewGraphWidget([]Plot{*line, *points})
+				chart.ChartTitle = "Title"
+				chart.XAxisTitle = "x"
+				chart.YAxisTitle = "y"
+				return chart.Scene(fyne.NewSize(400, 300), nil)
+			},
+			counts: [layerCount]int{7, 6, 13, 11, 6},
+			labels: []string{"Title", "0", "5.00", "10.0", "0", "5.00", "10.0", "X", "Y", "x", "y"},
+			legend: []string{"LEGEND", "line", "points"},
+		},
+		{
+			name: "pie",
+			scene: func() *Scene {
+				chart := NewPieChart([]PieSlice{*NewPieSlice("A", 3), *NewPieSlice("B", 1)})
+				chart.ChartTitle = "Share"
+				return chart.Scene(fyne.NewSize(400, 300), nil)
+			},
+			counts: [layerCount]int{2, 0, 0, 1, 5},
+			labels: []string{"Share"},
+			legend: []string{"LEGEND", "A", "B"},
+		},
+		{
+			name: "radar",
+			scene: func() *Scene {
+				axes := []RadarAxis{{Label: "a", Max: 10}, {Label: "b", Max: 10}, {Label: "c", Max: 10}}
+				chart := NewRadarChart(axes, []RadarSeries{*NewRadarSeries([]float32{1, 5, 9}, "series")})
+				return chart.Scene(fyne.NewSize(400, 300), nil)
+			},
+			counts: [layerCount]int{7, 15, 3, 8, 3},
+			labels: []string{"a", "b", "c", "2.00", "4.00", "6.00", "8.00", "10.0"},
+			legend: []string{"LEGEND", "series"},
+		},
+		{
+			name: "sparkline",
+			scene: func() *Scene {
+				spark := NewSparkline([]float32{1, 3, 2, 5})
+				spark.ShowLastMarker = true
+				return spark.Scene(fyne.NewSize(100, 20), nil)
+			},
+			counts: [layerCount]int{4, 0, 0, 0, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counts, texts := sceneContents(tt.scene())
+			if counts != tt.counts {
+				t.Errorf("primitives per layer = %v, want %v", counts, tt.counts)
+			}
+			if !reflect.DeepEqual(texts[layerLabels], tt.labels) {
+				t.Errorf("labels = %q, want %q", texts[layerLabels], tt.labels)
+			}
+			if !reflect.DeepEqual(texts[layerLegend], tt.legend) {
+				t.Errorf("legend = %q, want %q", texts[layerLegend], tt.legend)
+			}
+		})
+	}
+}
+
+// Drawing the same scene again reuses the objects and updates none of them
+func TestCanvasPoolReuse(t *testing.T) {
+	chart := NewGraphWidget([]Plot{
+		*NewPlot([]Node{{X: 0, Y: 0}, {X: 1, Y: 1}}, "a"),
+		*NewPlot([]Node{{X: 0, Y: 1}, {X: 1, Y: 0}}, "b"),
+	})
+	var pool canvasPool
+
+	first := append([]fyne.CanvasObject{}, pool.draw(chart.Scene(fyne.NewSize(400, 300), nil))...)
+	if len(pool.changed) != len(first) {
+		t.Fatalf("first draw: %d changed objects, want all %d", len(pool.changed), len(first))
+	}
+
+	again := pool.draw(chart.Scene(fyne.NewSize(400, 300), nil))
+	if len(pool.changed) != 0 {
+		t.Errorf("same scene: %d changed objects, want none", len(pool.changed))
+	}
+	if !sameObjects(again, first, nil) {
+		t.Error("same scene: objects were not reused")
+	}
+}
+
+// The renderer's object list is rebuilt, so the whole widget is redrawn,
+// when scene objects or overlays are added or dropped, and kept otherwise
+func TestSceneRendererRebuilt(t *testing.T) {
+	test.NewApp()
+	chart := NewGraphWidget([]Plot{
+		*NewPlot([]Node{{X: 0, Y: 0}, {X: 1, Y: 1}}, "a"),
+		*NewPlot([]Node{{X: 0, Y: 1}, {X: 1, Y: 0}}, "b"),
+	})
+	chart.Resize(fyne.NewSize(400, 300))
+	r := test.WidgetRenderer(chart).(*scatterChartRenderer)
+	holds := func(object fyne.CanvasObject) bool {
+		for _, o := range r.Objects() {
+			if o == object {
+				return true
+			}
+		}
+		return false
+	}
+
+	r.Refresh()
+	if r.rebuilt {
+		t.Error("unchanged chart: object list rebuilt")
+	}
+
+	label := widget.NewLabel("peak")
+	chart.AddOverlay(label, DataAnchor(0.5, 0.5))
+	if !r.rebuilt || !holds(label) {
+		t.Errorf("overlay added: rebuilt = %v, shown = %v, want both", r.rebuilt, holds(label))
+	}
+
+	r.Refresh()
+	if r.rebuilt {
+		t.Error("overlay kept: object list rebuilt")
+	}
+
+	chart.Overlays = nil
+	r.Refresh()
+	if !r.rebuilt || holds(label) {
+		t.Errorf("overlay removed: rebuilt = %v, shown = %v, want rebuilt and hidden", r.rebuilt, holds(label))
+	}
+
+	count := len(r.Objects())
+	chart.Plots = chart.Plots[:1]
+	r.Refresh()
+	if !r.rebuilt || len(r.Objects()) >= count {
+		t.Errorf("plot removed: rebuilt = %v with %d objects, want fewer than %d", r.rebuilt, len(r.Objects()), count)
+	}
+}
@@ -0,0 +1,36 @@
+package fynesimplechart_test
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+
+	"github.com/alexiusacademia/fynesimplechart"
+)
+
+// Layout places labels with the scene's measurer, so a wider title starts
+// further left to stay centered
+func TestSceneMeasurer(t *testing.T) {
+	wide := func(text string, size float32, style fyne.TextStyle) fyne.Size {
+		return fynesimplechart.ApproximateTextSize(text+text, size, style)
+	}
+	titleX := func(s *fynesimplechart.Scene) float32 {
+		for _, primitive := range s.Primitives {
+			if text, ok := primitive.(*fynesimplechart.SceneText); ok && text.Text == "Title" {
+				return text.Position.X
+			}
+		}
+		t.Fatal("no title in scene")
+		return 0
+	}
+
+	plot := fynesimplechart.NewPlot([]fynesimplechart.Node{{X: 0, Y: 0}, {X: 1, Y: 1}}, "plot")
+	chart := fynesimplechart.NewGraphWidget([]fynesimplechart.Plot{*plot})
+	chart.ChartTitle = "Title"
+	narrow := titleX(chart.Scene(fyne.NewSize(400, 300), nil))
+	wider := titleX(chart.Scene(fyne.NewSize(400, 300), wide))
+
+	if wider >= narrow {
+		t.Errorf("title at %v with a wide measurer, want left of %v", wider, narrow)
+	}
+}
@@ -0,0 +1,199 @@
+package fynesimplechart
+
+import (
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Sparkline is a small chart without axes, titles or legend for use inside
+// dense layouts such as table rows. The series is drawn by the same code as
+// ScatterPlot, so line styles, fills and gaps behave the same.
+type Sparkline struct {
+	widget.BaseWidget
+
+	Series Plot // The series to draw; Series.Title is not shown
+
+	// Marker properties
+	ShowMinMarker  bool
+	ShowMaxMarker  bool
+	ShowLastMarker bool
+	MinColor       color.Color // nil = red
+	MaxColor       color.Color // nil = green
+	LastColor      color.Color // nil uses the series color
+	MarkerSize     float32
+
+	// Reference band, e.g. a normal range (nil bounds = no band)
+	BandLow   *float32
+	BandHigh  *float32
+	BandColor color.Color // nil uses theme foreground with transparency
+
+	Padding float32 // Inset in pixels so markers and thick lines are not clipped
+}
+
+// NewSparkline creates a sparkline of values at X = 0, 1, 2...
+func NewSparkline(values []float32) *Sparkline {
+	nodes := make([]Node, len(values))
+	for i, v := range values {
+		nodes[i] = Node{X: float32(i), Y: v}
+	}
+
+	series := NewPlot(nodes, "")
+	series.ShowLine = true
+	series.ShowPoints = false
+	series.LineWidth = 1
+
+	w := &Sparkline{
+		Series:         *series,
+		ShowMinMarker:  false,
+		ShowMaxMarker:  false,
+		ShowLastMarker: false,
+		MinColor:       nil,
+		MaxColor:       nil,
+		LastColor:      nil,
+		MarkerSize:     2,
+		BandLow:        nil,
+		BandHigh:       nil,
+		BandColor:      nil,
+		Padding:        3,
+	}
+	w.ExtendBaseWidget(w)
+	return w
+}
+
+// SetValues replaces the series with values at X = 0, 1, 2...
+func (s *Sparkline) SetValues(values []float32) {
+	nodes := make([]Node, len(values))
+	for i, v := range values {
+		nodes[i] = Node{X: float32(i), Y: v}
+	}
+	s.Series.Nodes = nodes
+	s.Refresh()
+}
+
+// Generates a new renderer for the Sparkline.
+func (s *Sparkline) CreateRenderer() fyne.WidgetRenderer {
+	s.ExtendBaseWidget(s)
+	r := &sparklineRenderer{widget: s}
+	r.sceneRenderer = sceneRenderer{owner: s, layout: r.layout}
+	return r
+}
+
+// Scene lays the sparkline out at the given size without drawing it, in the
+// colors of Fyne's dark theme. A nil measurer uses ApproximateTextSize, so
+// no running app is needed.
+func (s *Sparkline) Scene(size fyne.Size, measure TextMeasurer) *Scene {
+	r := &sparklineRenderer{widget: s}
+	r.scene = newScene(size, measure)
+	r.layout()
+	return r.scene
+}
+
+// Responsible for rendering the Sparkline.
+type sparklineRenderer struct {
+	sceneRenderer
+	widget *Sparkline
+}
+
+// Sparklines are small but still need room for a readable trend.
+func (r *sparklineRenderer) MinSize() fyne.Size {
+	return fyne.NewSize(60, 20)
+}
+
+// Lay the sparkline out into the scene
+func (r *sparklineRenderer) layout() {
+	s := r.widget
+	nodes := finiteNodes(s.Series.Nodes)
+	if len(nodes) == 0 {
+		return
+	}
+
+	// Data range, including the reference band so it is always visible
+	var bounds dataRange
+	for _, n := range nodes {
+		bounds.include(n.X, n.Y)
+	}
+	if s.BandLow != nil && s.BandHigh != nil {
+		bounds.include(nodes[0].X, *s.BandLow)
+		bounds.include(nodes[0].X, *s.BandHigh)
+	}
+
+	minX, maxX, minY, maxY := bounds.minX, bounds.maxX, bounds.minY, bounds.maxY
+	if maxX == minX {
+		minX -= 0.5
+		maxX += 0.5
+	}
+	if maxY == minY {
+		minY -= 0.5
+		maxY += 0.5
+	}
+
+	size := r.scene.Size
+	padding := s.Padding
+	plotWidth := size.Width - 2*padding
+	plotHeight := size.Height - 2*padding
+	if plotWidth <= 0 || plotHeight <= 0 {
+		return
+	}
+
+	transform := newDataTransform(minX, maxX, minY, maxY, plotWidth, plotHeight, padding, padding)
+	dataToScreenX, dataToScreenY := transform.x, transform.y
+
+	// Draw the reference band behind the series
+	if s.BandLow != nil && s.BandHigh != nil {
+		bandColor := s.BandColor
+		if bandColor == nil {
+			bandColor = translucent(r.scene.Foreground)
+		}
+
+		top := dataToScreenY(float32(math.Max(float64(*s.BandLow), float64(*s.BandHigh))))
+		bottom := dataToScreenY(float32(math.Min(float64(*s.BandLow), float64(*s.BandHigh))))
+		band := r.scene.newRectangle(bandColor)
+		band.Move(fyne.NewPos(0, top))
+		band.Resize(fyne.NewSize(size.Width, bottom-top))
+		r.scene.add(band)
+	}
+
+	palette := generateColors(4)
+	seriesColor := palette[0]
+	if s.Series.PlotColor != nil {
+		seriesColor = s.Series.PlotColor
+	}
+
+	// Draw the series with the ScatterPlot renderer, minus its chrome
+	series := &scatterChartRenderer{widget: &ScatterPlot{Plots: []Plot{s.Series}}}
+	series.scene = r.scene
+	if s.Series.FillArea {
+		series.drawAreaFill(0, s.Series, seriesColor, minX, maxX, minY, maxY, plotWidth, plotHeight, padding, padding)
+	}
+	series.drawPlot(s.Series, seriesColor, minX, maxX, minY, maxY, plotWidth, plotHeight, padding, padding)
+
+	// Highlight the extremes and the latest value
+	minNode, maxNode := nodes[0], nodes[0]
+	for _, n := range nodes {
+		if n.Y < minNode.Y {
+			minNode = n
+		}
+		if n.Y > maxNode.Y {
+			maxNode = n
+		}
+	}
+
+	marker := func(n Node, markerColor, fallback color.Color) {
+		if markerColor == nil {
+			markerColor = fallback
+		}
+		series.drawMarker(MarkerCircle, dataToScreenX(n.X), dataToScreenY(n.Y), s.MarkerSize, markerColor, markerColor, 0)
+	}
+	if s.ShowMinMarker {
+		marker(minNode, s.MinColor, palette[3])
+	}
+	if s.ShowMaxMarker {
+		marker(maxNode, s.MaxColor, palette[2])
+	}
+	if s.ShowLastMarker {
+		marker(nodes[len(nodes)-1], s.LastColor, seriesColor)
+	}
+}
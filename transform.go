@@ -0,0 +1,204 @@
+package fynesimplechart
+
+import (
+	"errors"
+
+	"fyne.io/fyne/v2"
+)
+
+// VisibleRange returns the data range shown in the plot area: the manual
+// limits where set, otherwise the extent of the series with 10% padding on
+// each side. Padding is only added to an axis with neither limit set. In
+// polar mode X is the angle, from zero to a full turn, and Y the radius from
+// the pole to the outer ring.
+func (v *ScatterPlot) VisibleRange() (minX, maxX, minY, maxY float32, err error) {
+	if v.Polar {
+		minR, maxR, _, ok := v.radialRange()
+		if !ok {
+			return 0, 0, 0, 0, errors.New("No nodes to iterate.")
+		}
+		return 0, float32(v.AngleUnit.fullTurn()), minR, maxR, nil
+	}
+	return v.visibleRange(v.derivedPlots())
+}
+
+// visibleRange returns the Cartesian visible range, with the derived series
+// as computed by derivedPlots
+func (v *ScatterPlot) visibleRange(derived []Plot) (minX, maxX, minY, maxY float32, err error) {
+	bounds := v.dataBounds(derived)
+	if !bounds.ok {
+		return 0, 0, 0, 0, errors.New("No nodes to iterate.")
+	}
+
+	minX, maxX, minY, maxY = bounds.minX, bounds.maxX, bounds.minY, bounds.maxY
+	if v.MinX != nil {
+		minX = *v.MinX
+	}
+	if v.MaxX != nil {
+		maxX = *v.MaxX
+	}
+	if v.MinY != nil {
+		minY = *v.MinY
+	}
+	if v.MaxY != nil {
+		maxY = *v.MaxY
+	}
+
+	// Add 10% padding to the data range (only if using auto-calculated ranges)
+	if v.MinX == nil && v.MaxX == nil {
+		if rangeX := maxX - minX; rangeX == 0 {
+			minX -= 0.5
+			maxX += 0.5
+		} else {
+			minX -= rangeX * 0.1
+			maxX += rangeX * 0.1
+		}
+	}
+
+	if v.MinY == nil && v.MaxY == nil {
+		if rangeY := maxY - minY; rangeY == 0 {
+			minY -= 0.5
+			maxY += 0.5
+		} else {
+			minY -= rangeY * 0.1
+			maxY += rangeY * 0.1
+		}
+	}
+
+	return minX, maxX, minY, maxY, nil
+}
+
+// PlotArea returns the top left corner and size of the plot area inside the
+// widget, between the margins for the title, axes and legend. In polar mode
+// it is the square around the outer ring.
+func (v *ScatterPlot) PlotArea() (fyne.Position, fyne.Size) {
+	return v.currentView().area()
+}
+
+// plotArea returns the plot area for a chart of the given size
+func (v *ScatterPlot) plotArea(size fyne.Size) (fyne.Position, fyne.Size) {
+	return fyne.NewPos(v.mLeft, v.mTop), fyne.NewSize(size.Width-v.mLeft-v.mRight, size.Height-v.mTop-v.mBottom)
+}
+
+// DataToScreen returns the position of the data point (x, y) relative to the
+// widget's top left corner, as the chart was last laid out. Without data the
+// unit range is used. In polar mode x is the angle and y the radius.
+func (v *ScatterPlot) DataToScreen(x, y float32) fyne.Position {
+	return v.currentView().position(x, y)
+}
+
+// ScreenToData returns the data coordinates at a position relative to the
+// widget's top left corner, such as the one in a pointer event, as the chart
+// was last laid out. Positions outside the plot area give values outside the
+// visible range. In polar mode x is the angle, from zero to a full turn, and
+// y the radius.
+func (v *ScatterPlot) ScreenToData(screen fyne.Position) (x, y float32) {
+	return v.currentView().data(screen)
+}
+
+// currentView returns the mapping of the chart's last layout on screen, or
+// of its current data and size if it has not been laid out yet
+func (v *ScatterPlot) currentView() chartView {
+	if view := v.view.Load(); view != nil {
+		return *view
+	}
+	return v.newView(v.Size())
+}
+
+// newView returns the mapping for a chart of the given size. Without data the
+// unit range is used.
+func (v *ScatterPlot) newView(size fyne.Size) chartView {
+	if v.Polar {
+		frame, _ := v.polarFrame(size)
+		return chartView{polar: true, frame: frame}
+	}
+
+	minX, maxX, minY, maxY, err := v.VisibleRange()
+	if err != nil {
+		minX, maxX, minY, maxY = 0, 1, 0, 1
+	}
+	pos, area := v.plotArea(size)
+	return chartView{transform: newDataTransform(minX, maxX, minY, maxY, area.Width, area.Height, pos.X, pos.Y)}
+}
+
+// chartView maps between data and the screen for one layout of a chart,
+// through the visible range and plot area or the polar frame
+type chartView struct {
+	polar     bool
+	frame     polarFrame
+	transform dataTransform
+}
+
+// area returns the plot area of the view
+func (c chartView) area() (fyne.Position, fyne.Size) {
+	if c.polar {
+		return fyne.NewPos(c.frame.center.X-c.frame.radius, c.frame.center.Y-c.frame.radius), fyne.NewSize(2*c.frame.radius, 2*c.frame.radius)
+	}
+	return fyne.NewPos(c.transform.left, c.transform.top), fyne.NewSize(c.transform.width, c.transform.height)
+}
+
+// position returns the screen position of a data point
+func (c chartView) position(x, y float32) fyne.Position {
+	if c.polar {
+		return c.frame.position(x, y)
+	}
+	return c.transform.position(x, y)
+}
+
+// data returns the data coordinates at a screen position
+func (c chartView) data(screen fyne.Position) (x, y float32) {
+	if c.polar {
+		return c.frame.data(screen)
+	}
+	return c.transform.data(screen)
+}
+
+// dataTransform maps data coordinates in a visible range linearly to a plot
+// area on screen, with Y growing upwards
+type dataTransform struct {
+	minX, maxX, minY, maxY   float32
+	width, height, left, top float32
+}
+
+func newDataTransform(minX, maxX, minY, maxY, plotWidth, plotHeight, mLeft, mTop float32) dataTransform {
+	return dataTransform{
+		minX:   minX,
+		maxX:   maxX,
+		minY:   minY,
+		maxY:   maxY,
+		width:  plotWidth,
+		height: plotHeight,
+		left:   mLeft,
+		top:    mTop,
+	}
+}
+
+// x returns the screen X of a data X
+func (t dataTransform) x(x float32) float32 {
+	return t.left + ((x-t.minX)/(t.maxX-t.minX))*t.width
+}
+
+// y returns the screen Y of a data Y
+func (t dataTransform) y(y float32) float32 {
+	return t.top + t.height - ((y-t.minY)/(t.maxY-t.minY))*t.height
+}
+
+// position returns the screen position of a data point
+func (t dataTransform) position(x, y float32) fyne.Position {
+	return fyne.NewPos(t.x(x), t.y(y))
+}
+
+// data returns the data coordinates at a screen position
+func (t dataTransform) data(screen fyne.Position) (x, y float32) {
+	return t.dataX(screen.X), t.dataY(screen.Y)
+}
+
+// dataX returns the data X at a screen X
+func (t dataTransform) dataX(x float32) float32 {
+	return t.minX + ((x-t.left)/t.width)*(t.maxX-t.minX)
+}
+
+// dataY returns the data Y at a screen Y
+func (t dataTransform) dataY(y float32) float32 {
+	return t.minY + ((t.top+t.height-y)/t.height)*(t.maxY-t.minY)
+}
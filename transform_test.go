@@ -0,0 +1,91 @@
+package fynesimplechart_test
+
+import (
+	"math"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/alexiusacademia/fynesimplechart"
+)
+
+// Data converted to the screen and back comes out as it went in, on
+// Cartesian and polar charts
+func TestTransformRoundTrip(t *testing.T) {
+	test.NewApp()
+
+	tests := []struct {
+		name      string
+		polar     bool
+		clockwise bool
+		x, y      float32
+	}{
+		{name: "cartesian", x: 3, y: 7},
+		{name: "polar", polar: true, x: 45, y: 5},
+		{name: "polar clockwise", polar: true, clockwise: true, x: 300, y: 2.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plot := fynesimplechart.NewPlot([]fynesimplechart.Node{{X: 0, Y: 0}, {X: 90, Y: 10}}, "plot")
+			chart := fynesimplechart.NewGraphWidget([]fynesimplechart.Plot{*plot})
+			chart.Polar = tt.polar
+			chart.Clockwise = tt.clockwise
+			chart.Resize(fyne.NewSize(500, 400))
+
+			x, y := chart.ScreenToData(chart.DataToScreen(tt.x, tt.y))
+			if math.Abs(float64(x-tt.x)) > 1e-3 || math.Abs(float64(y-tt.y)) > 1e-3 {
+				t.Errorf("round trip of (%v, %v) = (%v, %v)", tt.x, tt.y, x, y)
+			}
+		})
+	}
+}
+
+// In polar mode the visible range is a full turn of angles and the radii
+// from the pole to the outer ring, whose square is the plot area
+func TestPolarVisibleRange(t *testing.T) {
+	test.NewApp()
+
+	plot := fynesimplechart.NewPlot([]fynesimplechart.Node{{X: 0, Y: 3}, {X: 90, Y: 9}}, "plot")
+	chart := fynesimplechart.NewGraphWidget([]fynesimplechart.Plot{*plot})
+	chart.Polar = true
+	chart.Resize(fyne.NewSize(500, 400))
+
+	minX, maxX, minY, maxY, err := chart.VisibleRange()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if minX != 0 || maxX != 360 || minY != 0 || maxY != 10 {
+		t.Errorf("visible range = %v..%v, %v..%v, want 0..360, 0..10", minX, maxX, minY, maxY)
+	}
+
+	pos, size := chart.PlotArea()
+	if size.Width != size.Height {
+		t.Errorf("plot area = %v, want a square", size)
+	}
+	if edge := chart.DataToScreen(0, maxY); math.Abs(float64(edge.X-(pos.X+size.Width))) > 1e-3 {
+		t.Errorf("outer ring at angle zero is at %v, want the right edge of %v, %v", edge, pos, size)
+	}
+}
+
+// On screen, conversions follow the last layout until the chart is redrawn
+func TestTransformLastLayout(t *testing.T) {
+	test.NewApp()
+
+	plot := fynesimplechart.NewPlot([]fynesimplechart.Node{{X: 0, Y: 0}, {X: 10, Y: 10}}, "plot")
+	chart := fynesimplechart.NewGraphWidget([]fynesimplechart.Plot{*plot})
+	chart.Resize(fyne.NewSize(500, 400))
+	test.WidgetRenderer(chart).Layout(chart.Size())
+
+	before := chart.DataToScreen(5, 5)
+	chart.Plots[0].Nodes = append(chart.Plots[0].Nodes, fynesimplechart.Node{X: 20, Y: 20})
+	if got := chart.DataToScreen(5, 5); got != before {
+		t.Errorf("before redraw: (5, 5) at %v, want %v as laid out", got, before)
+	}
+
+	chart.Refresh()
+	if got := chart.DataToScreen(5, 5); got == before {
+		t.Errorf("after redraw: (5, 5) still at %v", got)
+	}
+}
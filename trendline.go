@@ -0,0 +1,353 @@
+package fynesimplechart
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"math"
+	"strings"
+
+	"fyne.io/fyne/v2"
+)
+
+// FitType defines the model a trendline fits to a series
+type FitType int
+
+const (
+	FitLinear      FitType = iota // y = c0 + c1·x
+	FitPolynomial                 // y = c0 + c1·x + ... + cn·xⁿ
+	FitExponential                // y = c0·e^(c1·x), needs positive Y
+	FitLogarithmic                // y = c0 + c1·ln(x), needs positive X
+	FitPower                      // y = c0·x^c1, needs positive X and Y
+)
+
+// Trendline is a fitted curve drawn over a plot across its X range
+type Trendline struct {
+	Type   FitType
+	Degree int // Polynomial degree for FitPolynomial
+
+	Color       color.Color // nil uses the plot color
+	LineWidth   float32
+	LineStyle   LineStyle
+	DashPattern []float32 // On/off lengths in pixels for LineCustom
+
+	// Annotation properties
+	ShowEquation bool
+	ShowRSquared bool
+	LabelFormat  string // Format for coefficients (e.g., "%.3g")
+}
+
+func NewTrendline(fitType FitType) *Trendline {
+	trendline := &Trendline{
+		Type:         fitType,
+		Degree:       2,
+		Color:        nil, // Will use the plot color if nil
+		LineWidth:    1.5,
+		LineStyle:    LineDashed,
+		DashPattern:  nil,
+		ShowEquation: false,
+		ShowRSquared: false,
+		LabelFormat:  "%.3g",
+	}
+
+	return trendline
+}
+
+// Fit is the result of fitting a model to nodes. Coefficients follow the
+// formulas of the FitType constants; polynomial ones are in ascending powers.
+type Fit struct {
+	Type         FitType
+	Coefficients []float64
+	RSquared     float64 // Coefficient of determination on the original Y values
+
+	// X range of the fitted nodes
+	MinX float32
+	MaxX float32
+}
+
+// Eval returns the fitted value at x
+func (f Fit) Eval(x float64) float64 {
+	c := f.Coefficients
+	switch f.Type {
+	case FitExponential:
+		return c[0] * math.Exp(c[1]*x)
+	case FitLogarithmic:
+		return c[0] + c[1]*math.Log(x)
+	case FitPower:
+		return c[0] * math.Pow(x, c[1])
+	default:
+		y := 0.0
+		for k := len(c) - 1; k >= 0; k-- {
+			y = y*x + c[k]
+		}
+		return y
+	}
+}
+
+// Equation returns the fitted formula, e.g. "y = 2.1x + 0.5", with
+// coefficients printed using format
+func (f Fit) Equation(format string) string {
+	if format == "" {
+		format = "%.3g"
+	}
+	num := func(v float64) string {
+		return fmt.Sprintf(format, v)
+	}
+	c := f.Coefficients
+
+	switch f.Type {
+	case FitExponential:
+		return fmt.Sprintf("y = %se^(%sx)", num(c[0]), num(c[1]))
+	case FitLogarithmic:
+		return "y = " + num(c[0]) + signedTerm(c[1], "ln(x)", num)
+	case FitPower:
+		return fmt.Sprintf("y = %sx^%s", num(c[0]), num(c[1]))
+	}
+
+	// Polynomials from the highest power down
+	superscripts := []string{"", "", "²", "³", "⁴", "⁵", "⁶", "⁷", "⁸", "⁹"}
+	terms := ""
+	for k := len(c) - 1; k >= 0; k-- {
+		variable := ""
+		switch {
+		case k == 1:
+			variable = "x"
+		case k > 1 && k < len(superscripts):
+			variable = "x" + superscripts[k]
+		case k > 1:
+			variable = fmt.Sprintf("x^%d", k)
+		}
+
+		if terms == "" {
+			terms = num(c[k]) + variable
+		} else if c[k] != 0 {
+			terms += signedTerm(c[k], variable, num)
+		}
+	}
+	return "y = " + terms
+}
+
+// signedTerm formats " + 2x" or " - 2x" for a coefficient after the first
+func signedTerm(coefficient float64, variable string, num func(float64) string) string {
+	if coefficient < 0 {
+		return " - " + num(-coefficient) + variable
+	}
+	return " + " + num(coefficient) + variable
+}
+
+// FitNodes fits a model to the finite nodes by least squares. Exponential,
+// logarithmic and power models are fitted as straight lines through the
+// logarithms of the values, as spreadsheets do.
+func FitNodes(nodes []Node, fitType FitType, degree int) (*Fit, error) {
+	nodes = finiteNodes(nodes)
+	if len(nodes) == 0 {
+		return nil, errors.New("No nodes to iterate.")
+	}
+
+	xs := make([]float64, len(nodes))
+	ys := make([]float64, len(nodes))
+	for i, n := range nodes {
+		xs[i], ys[i] = float64(n.X), float64(n.Y)
+		if (fitType == FitLogarithmic || fitType == FitPower) && xs[i] <= 0 {
+			return nil, errors.New("Logarithmic and power fits need positive X values.")
+		}
+		if (fitType == FitExponential || fitType == FitPower) && ys[i] <= 0 {
+			return nil, errors.New("Exponential and power fits need positive Y values.")
+		}
+	}
+
+	// Transform the model into a polynomial fit
+	u := make([]float64, len(xs))
+	v := make([]float64, len(ys))
+	for i := range xs {
+		u[i], v[i] = xs[i], ys[i]
+		if fitType == FitLogarithmic || fitType == FitPower {
+			u[i] = math.Log(xs[i])
+		}
+		if fitType == FitExponential || fitType == FitPower {
+			v[i] = math.Log(ys[i])
+		}
+	}
+
+	if fitType != FitPolynomial {
+		degree = 1
+	}
+	if degree < 1 {
+		return nil, errors.New("Polynomial degree must be at least 1.")
+	}
+
+	coefficients, err := fitPolynomial(u, v, degree)
+	if err != nil {
+		return nil, err
+	}
+	if fitType == FitExponential || fitType == FitPower {
+		coefficients[0] = math.Exp(coefficients[0])
+	}
+
+	fit := &Fit{Type: fitType, Coefficients: coefficients, MinX: nodes[0].X, MaxX: nodes[0].X}
+	for _, n := range nodes {
+		fit.MinX = float32(math.Min(float64(fit.MinX), float64(n.X)))
+		fit.MaxX = float32(math.Max(float64(fit.MaxX), float64(n.X)))
+	}
+
+	// R² = 1 - residual sum of squares / total sum of squares
+	mean := 0.0
+	for _, y := range ys {
+		mean += y
+	}
+	mean /= float64(len(ys))
+
+	residual, total := 0.0, 0.0
+	for i := range xs {
+		residual += math.Pow(ys[i]-fit.Eval(xs[i]), 2)
+		total += math.Pow(ys[i]-mean, 2)
+	}
+	fit.RSquared = 1
+	if total > 0 {
+		fit.RSquared = 1 - residual/total
+	}
+
+	return fit, nil
+}
+
+// Fit fits the trendline's model to the plot's nodes
+func (p Plot) Fit(t Trendline) (*Fit, error) {
+	return FitNodes(p.Nodes, t.Type, t.Degree)
+}
+
+// fitPolynomial returns the least squares polynomial coefficients in
+// ascending powers. X is centred and scaled while solving so high degrees
+// stay well conditioned.
+func fitPolynomial(xs, ys []float64, degree int) ([]float64, error) {
+	distinct := map[float64]bool{}
+	for _, x := range xs {
+		distinct[x] = true
+	}
+	if len(distinct) <= degree {
+		return nil, errors.New("Not enough distinct X values to fit.")
+	}
+
+	// Centre and scale X to [-1, 1]
+	lo, hi := xs[0], xs[0]
+	for _, x := range xs {
+		lo, hi = math.Min(lo, x), math.Max(hi, x)
+	}
+	centre, scale := (lo+hi)/2, (hi-lo)/2
+
+	// Normal equations A·c = b in the scaled variable
+	size := degree + 1
+	a := make([][]float64, size)
+	for row := range a {
+		a[row] = make([]float64, size+1)
+	}
+	for i, x := range xs {
+		t := (x - centre) / scale
+		powers := make([]float64, 2*size-1)
+		powers[0] = 1
+		for k := 1; k < len(powers); k++ {
+			powers[k] = powers[k-1] * t
+		}
+		for row := 0; row < size; row++ {
+			for col := 0; col < size; col++ {
+				a[row][col] += powers[row+col]
+			}
+			a[row][size] += powers[row] * ys[i]
+		}
+	}
+
+	// Gaussian elimination with partial pivoting
+	for col := 0; col < size; col++ {
+		pivot := col
+		for row := col + 1; row < size; row++ {
+			if math.Abs(a[row][col]) > math.Abs(a[pivot][col]) {
+				pivot = row
+			}
+		}
+		if math.Abs(a[pivot][col]) < 1e-12 {
+			return nil, errors.New("Not enough distinct X values to fit.")
+		}
+		a[col], a[pivot] = a[pivot], a[col]
+
+		for row := 0; row < size; row++ {
+			if row == col {
+				continue
+			}
+			factor := a[row][col] / a[col][col]
+			for k := col; k <= size; k++ {
+				a[row][k] -= factor * a[col][k]
+			}
+		}
+	}
+	scaled := make([]float64, size)
+	for k := range scaled {
+		scaled[k] = a[k][size] / a[k][k]
+	}
+
+	// Expand Σ scaled[k]·((x - centre)/scale)^k into powers of x
+	coefficients := make([]float64, size)
+	for k, s := range scaled {
+		term := s / math.Pow(scale, float64(k))
+		binomial := 1.0
+		for j := 0; j <= k; j++ {
+			// Coefficient of x^j in (x - centre)^k
+			coefficients[j] += term * binomial * math.Pow(-centre, float64(k-j))
+			binomial = binomial * float64(k-j) / float64(j+1)
+		}
+	}
+
+	return coefficients, nil
+}
+
+// Draw the trendlines of every plot, with their annotations stacked in the
+// top left corner of the plot area
+func (r *scatterChartRenderer) drawTrendlines(colors []color.Color, minX, maxX, minY, maxY, plotWidth, plotHeight, mLeft, mTop float32) {
+	labelY := mTop + 5
+	transform := newDataTransform(minX, maxX, minY, maxY, plotWidth, plotHeight, mLeft, mTop)
+
+	for i, plot := range r.widget.Plots {
+		for _, t := range plot.Trendlines {
+			fit, err := plot.Fit(t)
+			if err != nil {
+				continue
+			}
+
+			lineColor := colors[i]
+			if plot.PlotColor != nil {
+				lineColor = plot.PlotColor
+			}
+			if t.Color != nil {
+				lineColor = t.Color
+			}
+
+			// Sample the fitted curve like a function over the series' X range
+			curve := Function{F: fit.Eval, XMin: &fit.MinX, XMax: &fit.MaxX, Tolerance: 0.5}
+			line := Plot{Nodes: clipNodesY(curve.sample(minX, maxX, minY, maxY, plotWidth, plotHeight), minY, maxY)}
+			pattern := dashPattern(t.LineStyle, t.DashPattern, t.LineWidth)
+			for _, run := range line.lineRuns() {
+				points := make([]fyne.Position, len(run))
+				for k, n := range run {
+					points[k] = transform.position(n.X, n.Y)
+				}
+				r.drawPolyline(points, lineColor, t.LineWidth, pattern)
+			}
+
+			// Annotation
+			parts := []string{}
+			if t.ShowEquation {
+				parts = append(parts, fit.Equation(t.LabelFormat))
+			}
+			if t.ShowRSquared {
+				parts = append(parts, fmt.Sprintf("R² = %.4f", fit.RSquared))
+			}
+			if len(parts) == 0 {
+				continue
+			}
+
+			label := r.scene.newText(strings.Join(parts, "   "), lineColor)
+			label.TextSize = 10
+			label.Move(fyne.NewPos(mLeft+8, labelY))
+			r.scene.add(label)
+			labelY += label.MinSize().Height + 2
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package fynesimplechart
+
+import (
+	"math"
+	"testing"
+)
+
+// Fitting is stable for X far from zero, where unscaled normal equations
+// lose most of their precision
+func TestFitPolynomialOffsetX(t *testing.T) {
+	xs := []float64{1000, 1001, 1002, 1003, 1004}
+	ys := make([]float64, len(xs))
+	for i, x := range xs {
+		ys[i] = 0.5*x*x - x + 3
+	}
+
+	coefficients, err := fitPolynomial(xs, ys, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, x := range xs {
+		y := coefficients[0] + coefficients[1]*x + coefficients[2]*x*x
+		if math.Abs(y-ys[i]) > 1e-4 {
+			t.Errorf("fit at %v = %v, want %v", x, y, ys[i])
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package fynesimplechart_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/alexiusacademia/fynesimplechart"
+)
+
+// nodesOf returns nodes at the given X values with Y from f
+func nodesOf(xs []float32, f func(x float64) float64) []fynesimplechart.Node {
+	nodes := make([]fynesimplechart.Node, len(xs))
+	for i, x := range xs {
+		nodes[i] = fynesimplechart.Node{X: x, Y: float32(f(float64(x)))}
+	}
+	return nodes
+}
+
+func TestFitNodes(t *testing.T) {
+	tests := []struct {
+		name         string
+		nodes        []fynesimplechart.Node
+		fitType      fynesimplechart.FitType
+		degree       int
+		coefficients []float64
+		rSquared     float64
+		equation     string
+	}{
+		{
+			name:         "exact line",
+			nodes:        nodesOf([]float32{0, 1, 2, 3, 4}, func(x float64) float64 { return 2*x + 1 }),
+			fitType:      fynesimplechart.FitLinear,
+			coefficients: []float64{1, 2},
+			rSquared:     1,
+			equation:     "y = 2x + 1",
+		},
+		{
+			// Slope 0.5 through the means (2, 2); residuals -0.5, 1, -0.5
+			name:         "noisy line",
+			nodes:        []fynesimplechart.Node{{X: 1, Y: 1}, {X: 2, Y: 3}, {X: 3, Y: 2}},
+			fitType:      fynesimplechart.FitLinear,
+			coefficients: []float64{1, 0.5},
+			rSquared:     1 - 1.5/2,
+			equation:     "y = 0.5x + 1",
+		},
+		{
+			name:         "quadratic",
+			nodes:        nodesOf([]float32{0, 1, 2, 3, 4}, func(x float64) float64 { return x*x - 3*x + 2 }),
+			fitType:      fynesimplechart.FitPolynomial,
+			degree:       2,
+			coefficients: []float64{2, -3, 1},
+			rSquared:     1,
+			equation:     "y = 1x² - 3x + 2",
+		},
+		{
+			name:         "exponential",
+			nodes:        nodesOf([]float32{0, 1, 2, 3}, func(x float64) float64 { return 2 * math.Exp(0.5*x) }),
+			fitType:      fynesimplechart.FitExponential,
+			coefficients: []float64{2, 0.5},
+			rSquared:     1,
+			equation:     "y = 2e^(0.5x)",
+		},
+		{
+			name:         "logarithmic",
+			nodes:        nodesOf([]float32{1, 2, 4, 8}, func(x float64) float64 { return 1 + 2*math.Log(x) }),
+			fitType:      fynesimplechart.FitLogarithmic,
+			coefficients: []float64{1, 2},
+			rSquared:     1,
+			equation:     "y = 1 + 2ln(x)",
+		},
+		{
+			name:         "power",
+			nodes:        nodesOf([]float32{1, 2, 3, 4}, func(x float64) float64 { return 3 * x * x }),
+			fitType:      fynesimplechart.FitPower,
+			coefficients: []float64{3, 2},
+			rSquared:     1,
+			equation:     "y = 3x^2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fit, err := fynesimplechart.FitNodes(tt.nodes, tt.fitType, tt.degree)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(fit.Coefficients) != len(tt.coefficients) {
+				t.Fatalf("coefficients = %v, want %v", fit.Coefficients, tt.coefficients)
+			}
+			for k, want := range tt.coefficients {
+				if math.Abs(fit.Coefficients[k]-want) > 1e-5 {
+					t.Errorf("coefficients = %v, want %v", fit.Coefficients, tt.coefficients)
+					break
+				}
+			}
+			if math.Abs(fit.RSquared-tt.rSquared) > 1e-5 {
+				t.Errorf("R² = %v, want %v", fit.RSquared, tt.rSquared)
+			}
+			if equation := fit.Equation(""); equation != tt.equation {
+				t.Errorf("equation = %q, want %q", equation, tt.equation)
+			}
+		})
+	}
+}
+
+func TestFitNodesErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		nodes   []fynesimplechart.Node
+		fitType fynesimplechart.FitType
+		degree  int
+	}{
+		{name: "no nodes", nodes: nil, fitType: fynesimplechart.FitLinear},
+		{name: "one X value", nodes: []fynesimplechart.Node{{X: 1, Y: 1}, {X: 1, Y: 2}}, fitType: fynesimplechart.FitLinear},
+		{name: "degree too high", nodes: []fynesimplechart.Node{{X: 0, Y: 1}, {X: 1, Y: 2}}, fitType: fynesimplechart.FitPolynomial, degree: 2},
+		{name: "degree zero", nodes: []fynesimplechart.Node{{X: 0, Y: 1}, {X: 1, Y: 2}}, fitType: fynesimplechart.FitPolynomial, degree: 0},
+		{name: "logarithm of zero", nodes: []fynesimplechart.Node{{X: 0, Y: 1}, {X: 1, Y: 2}}, fitType: fynesimplechart.FitLogarithmic},
+		{name: "exponential of negative", nodes: []fynesimplechart.Node{{X: 0, Y: -1}, {X: 1, Y: 2}}, fitType: fynesimplechart.FitExponential},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if fit, err := fynesimplechart.FitNodes(tt.nodes, tt.fitType, tt.degree); err == nil {
+				t.Errorf("fit = %+v, want an error", fit)
+			}
+		})
+	}
+}
@@ -0,0 +1,236 @@
+package fynesimplechart
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+)
+
+// WaterfallStep is one bar of a waterfall chart
+type WaterfallStep struct {
+	Label   string
+	Value   float32 // Change applied to the running total (ignored for totals)
+	IsTotal bool    // Draw the running total as a full bar from zero
+}
+
+type Waterfall struct {
+	Steps []WaterfallStep
+	Title string
+	Start float32 // Running total before the first step
+
+	IncreaseColor color.Color // Color for positive steps (nil = green)
+	DecreaseColor color.Color // Color for negative steps (nil = red)
+	TotalColor    color.Color // Color for totals (nil uses auto-generated color)
+
+	BarWidth       float32 // Width of bars as a fraction of step spacing (default: 0.8)
+	BarBorderWidth float32 // Border width for bars (0 = no border)
+	BarBorderColor color.Color
+
+	ShowConnectors bool        // Dashed lines joining the top of each bar to the next
+	ConnectorColor color.Color // Color for connectors (nil uses theme foreground)
+
+	ShowDataLabels bool        // Show each step's change above or below its bar
+	LabelFormat    string      // Format string for changes (e.g., "%+.1f")
+	TotalFormat    string      // Format string for totals (e.g., "%.1f")
+	LabelColor     color.Color // Color for labels (nil uses theme foreground)
+	LabelSize      float32     // Font size for labels (0 = default 10)
+}
+
+func NewWaterfall(start float32, steps []WaterfallStep, title string) *Waterfall {
+	waterfall := &Waterfall{
+		Steps:          steps,
+		Title:          title,
+		Start:          start,
+		IncreaseColor:  nil,
+		DecreaseColor:  nil,
+		TotalColor:     nil, // Will use auto-generated color if nil
+		BarWidth:       0.8,
+		BarBorderWidth: 0,
+		BarBorderColor: nil,
+		ShowConnectors: true,
+		ConnectorColor: nil,
+		ShowDataLabels: true,
+		LabelFormat:    "%+.1f",
+		TotalFormat:    "%.1f",
+		LabelColor:     nil,
+		LabelSize:      10,
+	}
+
+	return waterfall
+}
+
+// waterfallBar is a resolved step: the bar spans from base to top
+type waterfallBar struct {
+	base, top float32
+	delta     float32
+	total     bool
+}
+
+// bars resolves each step against the running total
+func (w Waterfall) bars() []waterfallBar {
+	bars := make([]waterfallBar, 0, len(w.Steps))
+	running := w.Start
+
+	for _, step := range w.Steps {
+		if step.IsTotal {
+			bars = append(bars, waterfallBar{base: 0, top: running, delta: running, total: true})
+			continue
+		}
+
+		value := step.Value
+		if !isFinite(value) {
+			value = 0
+		}
+		bars = append(bars, waterfallBar{base: running, top: running + value, delta: value})
+		running += value
+	}
+
+	return bars
+}
+
+// stepX returns the X position of step i
+func (w Waterfall) stepX(i int) float32 {
+	return float32(i + 1)
+}
+
+// colors returns the increase, decrease and total colors
+func (w Waterfall) colors(totalColor color.Color) (increase, decrease, total color.Color) {
+	palette := generateColors(4)
+	increase, decrease, total = palette[2], palette[3], totalColor
+	if w.IncreaseColor != nil {
+		increase = w.IncreaseColor
+	}
+	if w.DecreaseColor != nil {
+		decrease = w.DecreaseColor
+	}
+	if w.TotalColor != nil {
+		total = w.TotalColor
+	}
+	return increase, decrease, total
+}
+
+// barWidth returns BarWidth, or the default of 0.8 if it is unset
+func (w Waterfall) barWidth() float32 {
+	if w.BarWidth <= 0 {
+		return 0.8
+	}
+	return w.BarWidth
+}
+
+// extendRange grows bounds to contain every bar
+func (w Waterfall) extendRange(bounds *dataRange) {
+	halfWidth := w.barWidth() / 2
+	for i, bar := range w.bars() {
+		x := w.stepX(i)
+		bounds.include(x-halfWidth, bar.base)
+		bounds.include(x+halfWidth, bar.top)
+	}
+}
+
+// Draw a waterfall chart with floating bars
+func (r *scatterChartRenderer) drawWaterfall(w Waterfall, totalColor color.Color, minX, maxX, minY, maxY, plotWidth, plotHeight, mLeft, mTop float32) {
+	bars := w.bars()
+	if len(bars) == 0 {
+		return
+	}
+
+	// Transform functions from data coordinates to screen coordinates
+	transform := newDataTransform(minX, maxX, minY, maxY, plotWidth, plotHeight, mLeft, mTop)
+	dataToScreenX, dataToScreenY := transform.x, transform.y
+
+	barWidthScreen := w.barWidth() * (plotWidth / (maxX - minX))
+
+	increaseColor, decreaseColor, totalColor := w.colors(totalColor)
+
+	connectorColor := w.ConnectorColor
+	if connectorColor == nil {
+		connectorColor = r.scene.Foreground
+	}
+
+	for i, bar := range bars {
+		centerX := dataToScreenX(w.stepX(i))
+		barX := centerX - barWidthScreen/2
+		baseY := dataToScreenY(bar.base)
+		topY := dataToScreenY(bar.top)
+
+		barColor := increaseColor
+		if bar.total {
+			barColor = totalColor
+		} else if bar.delta < 0 {
+			barColor = decreaseColor
+		}
+
+		barY := float32(math.Min(float64(baseY), float64(topY)))
+		barHeight := float32(math.Abs(float64(topY - baseY)))
+		if barHeight >= 0.5 {
+			r.drawBar(barX, barY, barWidthScreen, barHeight, barColor, w.BarBorderWidth, w.BarBorderColor)
+		}
+
+		// Connector at the running total into the next bar
+		if w.ShowConnectors && i < len(bars)-1 {
+			nextX := dataToScreenX(w.stepX(i+1)) - barWidthScreen/2
+			points := []fyne.Position{fyne.NewPos(barX+barWidthScreen, topY), fyne.NewPos(nextX, topY)}
+			r.drawPolyline(points, connectorColor, 1, dashPattern(LineDashed, nil, 1))
+		}
+
+		if w.ShowDataLabels {
+			r.drawWaterfallLabel(w, bar, centerX, barY, barY+barHeight)
+		}
+
+		r.drawCategoryLabel(w.Steps[i].Label, centerX, mTop+plotHeight)
+	}
+}
+
+// Draw the change of a step above rising bars and below falling ones
+func (r *scatterChartRenderer) drawWaterfallLabel(w Waterfall, bar waterfallBar, centerX, barTop, barBottom float32) {
+	labelColor := w.LabelColor
+	if labelColor == nil {
+		labelColor = r.scene.Foreground
+	}
+
+	labelSize := w.LabelSize
+	if labelSize == 0 {
+		labelSize = 10
+	}
+
+	labelFormat := w.LabelFormat
+	if bar.total {
+		labelFormat = w.TotalFormat
+	}
+	if labelFormat == "" {
+		labelFormat = "%.1f"
+	}
+
+	label := r.scene.newText(fmt.Sprintf(labelFormat, bar.delta), labelColor)
+	label.TextSize = labelSize
+	labelWidth := label.MinSize().Width
+	labelHeight := label.MinSize().Height
+
+	labelY := barTop - labelHeight - 3
+	if bar.delta < 0 {
+		labelY = barBottom + 3
+	}
+
+	label.Move(fyne.NewPos(centerX-labelWidth/2, labelY))
+	r.scene.add(label)
+}
+
+// Draw a legend item showing the increase, decrease and total colors
+func (r *scatterChartRenderer) drawWaterfallLegendItem(w Waterfall, totalColor color.Color, x, y float32) {
+	increaseColor, decreaseColor, totalColor := w.colors(totalColor)
+
+	for i, swatchColor := range []color.Color{increaseColor, decreaseColor, totalColor} {
+		rect := r.scene.newRectangle(swatchColor)
+		rect.Resize(fyne.NewSize(4, 12))
+		rect.Move(fyne.NewPos(x+10+float32(i)*5, y+2))
+		r.scene.add(rect)
+	}
+
+	// Label
+	label := r.scene.newText(w.Title, r.scene.Foreground)
+	label.TextSize = 10
+	label.Move(fyne.NewPos(x+30, y))
+	r.scene.add(label)
+}
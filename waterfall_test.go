@@ -0,0 +1,67 @@
+package fynesimplechart
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+// Each step's bar runs from the running total before it to the one after;
+// totals run from zero and leave the running total as it is
+func TestWaterfallBars(t *testing.T) {
+	tests := []struct {
+		name  string
+		start float32
+		steps []WaterfallStep
+		want  []waterfallBar
+	}{
+		{
+			name:  "increase and decrease",
+			start: 10,
+			steps: []WaterfallStep{{Value: 5}, {Value: -8}},
+			want: []waterfallBar{
+				{base: 10, top: 15, delta: 5},
+				{base: 15, top: 7, delta: -8},
+			},
+		},
+		{
+			name:  "subtotal",
+			start: 0,
+			steps: []WaterfallStep{{Value: 4}, {Value: 6}, {IsTotal: true, Value: 99}, {Value: -3}, {IsTotal: true}},
+			want: []waterfallBar{
+				{base: 0, top: 4, delta: 4},
+				{base: 4, top: 10, delta: 6},
+				{base: 0, top: 10, delta: 10, total: true},
+				{base: 10, top: 7, delta: -3},
+				{base: 0, top: 7, delta: 7, total: true},
+			},
+		},
+		{
+			name:  "below zero",
+			start: 2,
+			steps: []WaterfallStep{{Value: -5}, {IsTotal: true}},
+			want: []waterfallBar{
+				{base: 2, top: -3, delta: -5},
+				{base: 0, top: -3, delta: -3, total: true},
+			},
+		},
+		{
+			name:  "missing value",
+			start: 1,
+			steps: []WaterfallStep{{Value: float32(math.NaN())}, {Value: 2}},
+			want: []waterfallBar{
+				{base: 1, top: 1, delta: 0},
+				{base: 1, top: 3, delta: 2},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewWaterfall(tt.start, tt.steps, "").bars()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("bars = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}